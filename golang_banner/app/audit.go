@@ -0,0 +1,388 @@
+package main
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Actions recorded in audit log
+const (
+	auditCreate  = "create"
+	auditUpdate  = "update"
+	auditDelete  = "delete"
+	auditRestore = "restore"
+	auditSubmit  = "submit"
+	auditApprove = "approve"
+	auditReject  = "reject"
+	auditPublish = "publish"
+	auditArchive = "archive"
+	auditTokens  = "tokens"
+)
+
+// Actor of entries the service writes on its own, like changes of token set
+const auditSystemActor = "system"
+
+// Advisory lock key that keeps instances started at once from logging the same token set twice
+const tokenAuditLockKey = 7040
+
+var auditActions = map[string]bool{
+	auditCreate:  true,
+	auditUpdate:  true,
+	auditDelete:  true,
+	auditRestore: true,
+	auditSubmit:  true,
+	auditApprove: true,
+	auditReject:  true,
+	auditPublish: true,
+	auditArchive: true,
+	auditTokens:  true,
+}
+
+// Default and maximal page size of GET /audit
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+// Columns of audit_log table in the order scanAuditEntry reads them
+const auditColumns = "id, created_at, actor, action, banner_id, diff, request_id, source_ip"
+
+// Who made a change and where the request came from. Every audit entry of a request gets the same source
+type auditSource struct {
+	actor     string
+	requestID string
+	sourceIP  string
+}
+
+// Builds audit source of REST request
+func (s *Server) auditSource(ctx echo.Context, token string) auditSource {
+	requestID := ctx.Response().Header().Get(echo.HeaderXRequestID)
+
+	if requestID == "" {
+		requestID = ctx.Request().Header.Get(echo.HeaderXRequestID)
+	}
+	return s.newAuditSource(token, requestID, ctx.RealIP())
+}
+
+// Builds audit source of request made with token. Tokens are secrets, so actor holds token role and fingerprint instead of the token
+func (s *Server) newAuditSource(token string, requestID string, sourceIP string) auditSource {
+	return auditSource{
+		actor:     s.tokenActor(token),
+		requestID: requestID,
+		sourceIP:  sourceIP,
+	}
+}
+
+// Role and fingerprint of token, the way audit log names who made a change
+func (s *Server) tokenActor(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return s.tokens[token] + ":" + hex.EncodeToString(sum[:8])
+}
+
+// Logs accepted tokens as actors when they differ from the last logged set. Tokens come from configuration,
+// so their changes are seen at startup. Entry has no banner, its diff holds actors before and after the change
+func (s *Server) auditTokenSet() error {
+	actors := make([]string, 0, len(s.tokens))
+	for token := range s.tokens {
+		actors = append(actors, s.tokenActor(token))
+	}
+	sort.Strings(actors)
+
+	tx, err := s.db.Begin()
+
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1)", tokenAuditLockKey); err != nil {
+		return err
+	}
+
+	var data []byte
+	err = tx.QueryRow("SELECT diff FROM audit_log WHERE action = $1 ORDER BY id DESC LIMIT 1", auditTokens).Scan(&data)
+
+	var last []string
+	if err == nil {
+		var diff struct {
+			Tokens struct {
+				After []string `json:"after"`
+			} `json:"tokens"`
+		}
+		if err := json.Unmarshal(data, &diff); err != nil {
+			return err
+		}
+		last = diff.Tokens.After
+	} else if err != sql.ErrNoRows {
+		return err
+	}
+
+	if reflect.DeepEqual(last, actors) {
+		return nil
+	}
+
+	var before interface{}
+	if last != nil {
+		before = last
+	}
+	diffJSON, err := json.Marshal(auditFieldDiff("tokens", before, actors))
+
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO audit_log (actor, action, diff) VALUES ($1, $2, $3)"
+	if _, err := tx.Exec(query, auditSystemActor, auditTokens, diffJSON); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Appends entry to audit log inside tx, so the entry is stored only if the change is
+func appendAudit(tx *sql.Tx, source auditSource, action string, bannerID int, diff map[string]AuditChange) error {
+	diffJSON, err := json.Marshal(diff)
+
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO audit_log (actor, action, banner_id, diff, request_id, source_ip) VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''))"
+	_, err = tx.Exec(query, source.actor, action, bannerID, diffJSON, source.requestID, source.sourceIP)
+	return err
+}
+
+// Builds diff of two JSON objects. Only changed fields are kept, nil document has no fields
+func auditDiff(before []byte, after []byte) (map[string]AuditChange, error) {
+	var oldDoc, newDoc map[string]interface{}
+
+	if before != nil {
+		if err := json.Unmarshal(before, &oldDoc); err != nil {
+			return nil, err
+		}
+	}
+	if after != nil {
+		if err := json.Unmarshal(after, &newDoc); err != nil {
+			return nil, err
+		}
+	}
+
+	diff := make(map[string]AuditChange)
+	for field, value := range oldDoc {
+		if other, ok := newDoc[field]; !ok || !reflect.DeepEqual(value, other) {
+			diff[field] = auditChange(value, other, true, ok)
+		}
+	}
+	for field, value := range newDoc {
+		if _, ok := oldDoc[field]; !ok {
+			diff[field] = auditChange(nil, value, false, true)
+		}
+	}
+	return diff, nil
+}
+
+// Builds change of one field. Missing sides are left out of the change
+func auditChange(before interface{}, after interface{}, hasBefore bool, hasAfter bool) AuditChange {
+	var change AuditChange
+
+	if hasBefore {
+		change.Before = &before
+	}
+	if hasAfter {
+		change.After = &after
+	}
+	return change
+}
+
+// Diff of single field whose value changed from before to after
+func auditFieldDiff(field string, before interface{}, after interface{}) map[string]AuditChange {
+	return map[string]AuditChange{field: auditChange(before, after, before != nil, after != nil)}
+}
+
+func (s *Server) GetAudit(ctx echo.Context, params GetAuditParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	page, writeErr, err := s.listAudit(params)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+
+	if page.nextCursor != "" {
+		ctx.Response().Header().Set("X-Next-Cursor", page.nextCursor)
+	}
+	return ctx.JSON(http.StatusOK, page.entries)
+}
+
+// Page of audit log with cursor of the next page, empty on the last one
+type auditPage struct {
+	entries    []AuditEntry
+	nextCursor string
+}
+
+// Checks filters and reads page of audit log. Used by REST and gRPC APIs
+func (s *Server) listAudit(params GetAuditParams) (auditPage, *bannerWriteError, error) {
+	page := auditPage{entries: []AuditEntry{}}
+	limit := defaultAuditLimit
+	var fieldErrs []FieldError
+	if params.Limit != nil {
+		limit = *params.Limit
+		if limit < 1 || limit > maxAuditLimit {
+			fieldErrs = append(fieldErrs, FieldError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d", maxAuditLimit)})
+		}
+	}
+	if params.Action != nil && !auditActions[*params.Action] {
+		fieldErrs = append(fieldErrs, FieldError{Field: "action", Message: "must be create, update, delete, restore, submit, approve, reject, publish, archive or tokens"})
+	}
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		fieldErrs = append(fieldErrs, FieldError{Field: "to", Message: "must be after from"})
+	}
+
+	var cursor *int64
+	if params.Cursor != nil {
+		decoded, err := decodeAuditCursor(*params.Cursor)
+
+		if err != nil {
+			fieldErrs = append(fieldErrs, FieldError{Field: "cursor", Message: err.Error()})
+		} else {
+			cursor = &decoded
+		}
+	}
+	if len(fieldErrs) > 0 {
+		return page, invalidBannerError(fieldErrs), nil
+	}
+
+	query, args := getAuditQueryBuilder(params, limit, cursor)
+	rows, err := s.db.Query(query, args...)
+
+	if err != nil {
+		return page, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entry, err := scanAuditEntry(rows)
+
+		if err != nil {
+			return page, nil, err
+		}
+		page.entries = append(page.entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return page, nil, err
+	}
+
+	// One entry more than limit is fetched to find out if there is a next page
+	if len(page.entries) > limit {
+		page.entries = page.entries[:limit]
+		page.nextCursor = encodeAuditCursor(int64(page.entries[limit-1].Id))
+	}
+	return page, nil, nil
+}
+
+// Reads audit entry from row selected with auditColumns
+func scanAuditEntry(row rowScanner) (AuditEntry, error) {
+	var entry AuditEntry
+	var bannerID sql.NullInt64
+	var requestID, sourceIP sql.NullString
+	var diff []byte
+	err := row.Scan(&entry.Id, &entry.CreatedAt, &entry.Actor, &entry.Action, &bannerID, &diff, &requestID, &sourceIP)
+
+	if err != nil {
+		return entry, err
+	}
+	if bannerID.Valid {
+		id := int(bannerID.Int64)
+		entry.BannerId = &id
+	}
+	if requestID.Valid {
+		entry.RequestId = &requestID.String
+	}
+	if sourceIP.Valid {
+		entry.SourceIp = &sourceIP.String
+	}
+	return entry, json.Unmarshal(diff, &entry.Diff)
+}
+
+// Wrapper function for building params for getAudit query. Entries are listed from the newest,
+// with cursor only entries older than it are selected
+func getAuditQueryBuilder(params GetAuditParams, limit int, cursor *int64) (string, []interface{}) {
+	query := "SELECT " + auditColumns + " FROM audit_log WHERE true"
+	args := []interface{}{}
+	count := 1
+
+	// Timestamps are stored without time zone in UTC
+	var from, to interface{}
+	if params.From != nil {
+		from = params.From.UTC()
+	}
+	if params.To != nil {
+		to = params.To.UTC()
+	}
+
+	filters := []struct {
+		condition string
+		value     interface{}
+		set       bool
+	}{
+		{"actor =", params.Actor, params.Actor != nil},
+		{"action =", params.Action, params.Action != nil},
+		{"banner_id =", params.BannerId, params.BannerId != nil},
+		{"created_at >=", from, from != nil},
+		{"created_at <", to, to != nil},
+		{"id <", cursor, cursor != nil},
+	}
+	for _, filter := range filters {
+		if filter.set {
+			query += fmt.Sprintf(" AND %s $%d", filter.condition, count)
+			args = append(args, filter.value)
+			count++
+		}
+	}
+
+	query += fmt.Sprintf(" ORDER BY id DESC LIMIT $%d", count)
+	args = append(args, limit+1)
+	return query, args
+}
+
+// Cursor of GET /audit, id of the last returned entry
+type auditCursor struct {
+	ID int64 `json:"id"`
+}
+
+// Builds opaque cursor pointing right after entry with given id
+func encodeAuditCursor(id int64) string {
+	data, _ := json.Marshal(auditCursor{ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decodes cursor of GET /audit
+func decodeAuditCursor(encoded string) (int64, error) {
+	var cursor auditCursor
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return 0, errors.New("malformed cursor")
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID < 1 {
+		return 0, errors.New("malformed cursor")
+	}
+	return cursor.ID, nil
+}
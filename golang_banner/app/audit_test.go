@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditDiff(t *testing.T) {
+	before := []byte(`{"tag_ids": [1, 2], "content": {"title": "a"}, "is_active": true}`)
+	after := []byte(`{"tag_ids": [1, 2], "content": {"title": "b"}, "priority": 3}`)
+
+	diff, err := auditDiff(before, after)
+
+	if assert.NoError(t, err) {
+		data, err := json.Marshal(diff)
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"content": {"before": {"title": "a"}, "after": {"title": "b"}},
+			"is_active": {"before": true},
+			"priority": {"after": 3}
+		}`, string(data))
+	}
+}
+
+func TestAuditSourceHidesToken(t *testing.T) {
+	server, _, _ := newTestServer(t)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/banner", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-1")
+	req.Header.Set(echo.HeaderXRealIP, "10.0.0.7")
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	source := server.auditSource(c, "IGOTTHEPOWER!")
+
+	assert.True(t, strings.HasPrefix(source.actor, "admin:"))
+	assert.NotContains(t, source.actor, "IGOTTHEPOWER!")
+	assert.Equal(t, "req-1", source.requestID)
+	assert.Equal(t, "10.0.0.7", source.sourceIP)
+}
+
+func TestGetAudit(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	// One entry more than limit means there is a next page
+	rows := sqlmock.NewRows([]string{"id", "created_at", "actor", "action", "banner_id", "diff", "request_id", "source_ip"}).
+		AddRow(12, now, "admin:0011223344556677", "update", 7, []byte(`{"is_active": {"before": false, "after": true}}`), "req-1", "10.0.0.7").
+		AddRow(9, now, "admin:0011223344556677", "update", 7, []byte(`{}`), nil, nil)
+	db_mock.ExpectQuery("SELECT "+auditColumns+" FROM audit_log WHERE true AND banner_id = $1 AND id < $2 ORDER BY id DESC LIMIT $3").
+		WithArgs(7, 20, 2).
+		WillReturnRows(rows)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/audit?banner_id=7&limit=1&cursor="+encodeAuditCursor(20), nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetAudit(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, encodeAuditCursor(12), rec.Header().Get("X-Next-Cursor"))
+
+		var entries []AuditEntry
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entries))
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, 12, entries[0].Id)
+			assert.Equal(t, "req-1", *entries[0].RequestId)
+			assert.Equal(t, true, *entries[0].Diff["is_active"].After)
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetAuditValidation(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	for _, test := range []struct {
+		query string
+		field string
+	}{
+		{"limit=0", "limit"},
+		{"limit=1001", "limit"},
+		{"action=rename", "action"},
+		{"cursor=abc", "cursor"},
+		{"from=2024-02-01T00:00:00Z&to=2024-01-01T00:00:00Z", "to"},
+	} {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/audit?"+test.query, nil)
+		req.Header.Set("token", "IGOTTHEPOWER!")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, wrapper.GetAudit(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code, test.query)
+			assert.Contains(t, rec.Body.String(), `"field":"`+test.field+`"`, test.query)
+		}
+	}
+}
+
+func TestAuditTokenSet(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	admin, user := server.tokenActor("IGOTTHEPOWER!"), server.tokenActor("IMACREEP")
+	lastQuery := "SELECT diff FROM audit_log WHERE action = $1 ORDER BY id DESC LIMIT 1"
+
+	// User token was added since the last start
+	db_mock.ExpectBegin()
+	db_mock.ExpectExec("SELECT pg_advisory_xact_lock($1)").
+		WithArgs(tokenAuditLockKey).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectQuery(lastQuery).
+		WithArgs(auditTokens).
+		WillReturnRows(sqlmock.NewRows([]string{"diff"}).AddRow([]byte(`{"tokens": {"after": ["` + admin + `"]}}`)))
+	diff := []byte(`{"tokens":{"after":["` + admin + `","` + user + `"],"before":["` + admin + `"]}}`)
+	db_mock.ExpectExec("INSERT INTO audit_log (actor, action, diff) VALUES ($1, $2, $3)").
+		WithArgs(auditSystemActor, auditTokens, diff).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	db_mock.ExpectCommit()
+	// Nothing changed since the last start
+	db_mock.ExpectBegin()
+	db_mock.ExpectExec("SELECT pg_advisory_xact_lock($1)").
+		WithArgs(tokenAuditLockKey).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectQuery(lastQuery).
+		WithArgs(auditTokens).
+		WillReturnRows(sqlmock.NewRows([]string{"diff"}).AddRow(diff))
+	db_mock.ExpectRollback()
+
+	assert.NoError(t, server.auditTokenSet())
+	assert.NoError(t, server.auditTokenSet())
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+func (s *Server) PostBannerBulk(ctx echo.Context, params PostBannerBulkParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	data, err := readRequestBodyLimit(ctx.Request().Body, maxBulkRequestSize)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	mode, items, fieldErrs := decodeBannerBulk(data)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+
+	response, err := s.applyBannerBulk(mode, items, s.auditSource(ctx, *params.Token))
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if mode == Atomic && !response.Applied {
+		return ctx.JSON(http.StatusUnprocessableEntity, response)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// Applies bulk items. Best effort mode applies each item in its own transaction, atomic mode applies all or none.
+// Used by REST and gRPC APIs
+func (s *Server) applyBannerBulk(mode BannerBulkRequestMode, items []bannerBulkItem, audit auditSource) (BannerBulkResponse, error) {
+	if mode == Atomic {
+		return s.applyBannerBulkAtomic(items, audit)
+	}
+
+	response := BannerBulkResponse{Results: make([]BannerBulkResult, len(items))}
+	for i, item := range items {
+		result, writeErr := bulkItemPrecheck(i, item, s.requireIfMatch)
+
+		if writeErr == nil {
+			var err error
+			writeErr, err = s.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+				return applyBulkItem(tx, item, &result, audit)
+			})
+
+			// Other items don't depend on this one, so it fails alone
+			if err != nil {
+				writeErr = &bannerWriteError{status: http.StatusInternalServerError, message: err.Error()}
+			}
+		}
+		if writeErr != nil {
+			setBulkItemError(&result, writeErr)
+		} else {
+			response.Applied = true
+		}
+		response.Results[i] = result
+	}
+	return response, nil
+}
+
+// Applies all items in one transaction. The first rejected item rolls back the whole request
+func (s *Server) applyBannerBulkAtomic(items []bannerBulkItem, audit auditSource) (BannerBulkResponse, error) {
+	response := BannerBulkResponse{Results: make([]BannerBulkResult, len(items))}
+	failed := false
+
+	// Items are checked before the transaction, so all invalid items are reported at once
+	for i, item := range items {
+		result, writeErr := bulkItemPrecheck(i, item, s.requireIfMatch)
+
+		if writeErr != nil {
+			setBulkItemError(&result, writeErr)
+			failed = true
+		}
+		response.Results[i] = result
+	}
+
+	if !failed {
+		tx, err := s.db.Begin()
+
+		if err != nil {
+			return response, err
+		}
+
+		if err := lockBulkItems(tx, items); err != nil {
+			tx.Rollback()
+			return response, err
+		}
+
+		for i, item := range items {
+			writeErr, err := applyBulkItem(tx, item, &response.Results[i], audit)
+
+			if err != nil {
+				tx.Rollback()
+				return response, err
+			}
+			if writeErr != nil {
+				setBulkItemError(&response.Results[i], writeErr)
+				failed = true
+				break
+			}
+		}
+
+		if failed {
+			tx.Rollback()
+		} else if err := tx.Commit(); err != nil {
+			return response, err
+		}
+	}
+
+	if failed {
+		for i := range response.Results {
+			result := &response.Results[i]
+
+			if result.Error == nil {
+				*result = BannerBulkResult{Index: result.Index, Status: http.StatusFailedDependency, Error: stringPtr("not applied because another item failed")}
+			}
+		}
+		return response, nil
+	}
+
+	response.Applied = true
+	return response, nil
+}
+
+// Locks rows of patched banners, then features of all items, each in ascending order, before any item is applied,
+// so concurrent atomic requests can't deadlock. Rows go before features like in a single patch.
+// Patched banners lock their current feature and the feature patch moves them to
+func lockBulkItems(tx *sql.Tx, items []bannerBulkItem) error {
+	var featureIDs, bannerIDs []int
+	for _, item := range items {
+		if item.op == Create {
+			featureIDs = append(featureIDs, item.input.FeatureId)
+			continue
+		}
+		bannerIDs = append(bannerIDs, item.id)
+
+		var patch struct {
+			FeatureID *int `json:"feature_id"`
+		}
+		if json.Unmarshal(item.patch, &patch) == nil && patch.FeatureID != nil {
+			featureIDs = append(featureIDs, *patch.FeatureID)
+		}
+	}
+
+	if len(bannerIDs) > 0 {
+		sort.Ints(bannerIDs)
+		rows, err := tx.Query("SELECT id, feature_id FROM banners WHERE id = ANY($1::int[]) ORDER BY id FOR UPDATE", pq.Array(uniqueIDs(bannerIDs)))
+
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id, featureID int
+			if err := rows.Scan(&id, &featureID); err != nil {
+				return err
+			}
+			featureIDs = append(featureIDs, featureID)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+	}
+	return lockFeatures(tx, featureIDs...)
+}
+
+// Builds result of bulk item and rejects items that can be rejected without database
+func bulkItemPrecheck(index int, item bannerBulkItem, requireIfMatch bool) (BannerBulkResult, *bannerWriteError) {
+	result := BannerBulkResult{Index: index}
+
+	if len(item.errs) > 0 {
+		return result, invalidBannerError(item.errs)
+	}
+	if item.op == Patch && requireIfMatch && item.ifMatch == nil {
+		return result, &bannerWriteError{status: http.StatusPreconditionRequired, message: "Требуется заголовок If-Match"}
+	}
+	return result, nil
+}
+
+// Applies one bulk item inside tx and fills its result
+func applyBulkItem(tx *sql.Tx, item bannerBulkItem, result *BannerBulkResult, audit auditSource) (*bannerWriteError, error) {
+	if item.op == Create {
+		id, writeErr, err := createBanner(tx, item.input, audit)
+
+		if err != nil || writeErr != nil {
+			return writeErr, err
+		}
+		result.Status = http.StatusCreated
+		result.Id = &id
+		return nil, nil
+	}
+
+	revision, writeErr, err := patchBanner(tx, item.id, item.ifMatch, mimeMergePatch, item.patch, audit)
+
+	if err != nil || writeErr != nil {
+		return writeErr, err
+	}
+	result.Status = http.StatusOK
+	result.Id = &item.id
+	result.Etag = stringPtr(bannerETag(revision))
+	return nil, nil
+}
+
+// Stores rejected write in bulk item result
+func setBulkItemError(result *BannerBulkResult, writeErr *bannerWriteError) {
+	result.Status = writeErr.status
+	result.Id = nil
+	result.Etag = nil
+	result.Error = stringPtr(writeErr.message)
+
+	if writeErr.fields != nil {
+		fields := writeErr.fields
+		result.Fields = &fields
+	}
+}
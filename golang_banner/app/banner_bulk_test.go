@@ -0,0 +1,332 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+const bannerUniqueQuery = `SELECT id, (SELECT t FROM unnest(tag_ids) AS t WHERE t = ANY($3::int[]) ORDER BY t LIMIT 1)
+	FROM banners
+	WHERE feature_id = $1 AND priority = $2 AND tag_ids && $3::int[] AND id <> $4 AND deleted_at IS NULL
+	ORDER BY id
+	LIMIT 1`
+
+// Expects advisory lock of feature taken by lockFeature
+func expectFeatureLock(db_mock sqlmock.Sqlmock, featureID int) {
+	db_mock.ExpectExec("SELECT pg_advisory_xact_lock('banners'::regclass::oid::int, $1)").
+		WithArgs(featureID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+// Expects checkBannerRefs queries for feature and tags that exist and are not archived
+func expectBannerRefs(db_mock sqlmock.Sqlmock, featureID int, tagIDs []int) {
+	db_mock.ExpectQuery("SELECT archived FROM features WHERE id = $1 FOR SHARE").
+		WithArgs(featureID).
+		WillReturnRows(sqlmock.NewRows([]string{"archived"}).AddRow(false))
+
+	rows := sqlmock.NewRows([]string{"id", "archived"})
+	for _, tagID := range tagIDs {
+		rows.AddRow(tagID, false)
+	}
+	db_mock.ExpectQuery("SELECT id, archived FROM tags WHERE id = ANY($1::int[]) FOR SHARE").
+		WithArgs(pq.Array(tagIDs)).
+		WillReturnRows(rows)
+}
+
+// Expects checkBannerContent query for feature with given schema. Nil schema means the feature has no schema
+func expectContentSchema(db_mock sqlmock.Sqlmock, featureID int, schema []byte) {
+	db_mock.ExpectQuery("SELECT content_schema FROM features WHERE id = $1").
+		WithArgs(featureID).
+		WillReturnRows(sqlmock.NewRows([]string{"content_schema"}).AddRow(schema))
+}
+
+// Expects queries of createBanner for banner with given feature and tags. Zero conflictID means there is no conflicting banner
+func expectCreateBanner(db_mock sqlmock.Sqlmock, featureID int, tagIDs []int, conflictID int, id int) {
+	expectBannerRefs(db_mock, featureID, tagIDs)
+	expectContentSchema(db_mock, featureID, nil)
+	expectFeatureLock(db_mock, featureID)
+
+	rows := sqlmock.NewRows([]string{"id", "t"})
+	if conflictID != 0 {
+		rows.AddRow(conflictID, tagIDs[0])
+	}
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(featureID, 0, pq.Array(tagIDs), 0).
+		WillReturnRows(rows)
+
+	if conflictID == 0 {
+		db_mock.ExpectQuery("INSERT INTO banners (content, feature_id, tag_ids, is_active, priority) VALUES ($1, $2, $3, $4, $5) RETURNING id").
+			WithArgs(sqlmock.AnyArg(), featureID, pq.Array(tagIDs), true, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+		expectBannerChange(db_mock, auditCreate, id)
+	}
+}
+
+// Expects audit entry and outbox events of action on banner. Actor, diff, event types and request source are not checked
+func expectBannerChange(db_mock sqlmock.Sqlmock, action string, bannerID int) {
+	db_mock.ExpectExec("INSERT INTO audit_log (actor, action, banner_id, diff, request_id, source_ip) VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''))").
+		WithArgs(sqlmock.AnyArg(), action, bannerID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	db_mock.ExpectExec("INSERT INTO banner_events (type, banner_id, changes) SELECT unnest($1::text[]), $2, $3").
+		WithArgs(sqlmock.AnyArg(), bannerID, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func postBannerBulk(t *testing.T, wrapper ServerInterfaceWrapper, body string) (*httptest.ResponseRecorder, BannerBulkResponse) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/banner/bulk", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var response BannerBulkResponse
+	if assert.NoError(t, wrapper.PostBannerBulk(c)) && rec.Code != http.StatusBadRequest {
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+		if err != nil {
+			t.Fatalf("Error occcured: %s", err.Error())
+		}
+	}
+	return rec, response
+}
+
+func TestDecodeBannerBulk(t *testing.T) {
+	mode, items, errs := decodeBannerBulk([]byte(`{"mode": "best_effort", "items": [
+		{"op": "create", "banner": {"tag_ids": [1], "feature_id": 2, "content": {}, "is_active": true}},
+		{"op": "create", "id": 3, "banner": {"tag_ids": [], "feature_id": 2, "content": {}, "is_active": true}},
+		{"op": "patch", "banner": {"is_active": false}},
+		{"op": "delete", "banner": {}}
+	]}`))
+
+	assert.Equal(t, BestEffort, mode)
+	assert.Empty(t, errs)
+	assert.Empty(t, items[0].errs)
+	assert.Equal(t, []FieldError{
+		{Field: "id", Message: "is allowed only for patch"},
+		{Field: "banner.tag_ids", Message: "must contain at least one tag"},
+	}, items[1].errs)
+	assert.Equal(t, []FieldError{{Field: "id", Message: "is required"}}, items[2].errs)
+	assert.Equal(t, []FieldError{{Field: "op", Message: "must be create or patch"}}, items[3].errs)
+
+	_, _, errs = decodeBannerBulk([]byte(`{"mode": "fast", "items": []}`))
+	assert.Equal(t, []FieldError{
+		{Field: "mode", Message: "must be atomic or best_effort"},
+		{Field: "items", Message: "must contain at least one item"},
+	}, errs)
+}
+
+func TestPostBannerBulkAtomic(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	expectFeatureLock(db_mock, 2)
+	expectCreateBanner(db_mock, 2, []int{1}, 0, 10)
+	expectCreateBanner(db_mock, 2, []int{3}, 0, 11)
+	db_mock.ExpectCommit()
+
+	rec, response := postBannerBulk(t, wrapper, `{"items": [
+		{"op": "create", "banner": {"tag_ids": [1], "feature_id": 2, "content": {}, "is_active": true}},
+		{"op": "create", "banner": {"tag_ids": [3], "feature_id": 2, "content": {}, "is_active": true}}
+	]}`)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, response.Applied)
+	assert.Equal(t, http.StatusCreated, response.Results[1].Status)
+	assert.Equal(t, 11, *response.Results[1].Id)
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerBulkAtomicConflict(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	expectFeatureLock(db_mock, 2)
+	expectCreateBanner(db_mock, 2, []int{1}, 0, 10)
+	expectCreateBanner(db_mock, 2, []int{1}, 10, 0)
+	db_mock.ExpectRollback()
+
+	rec, response := postBannerBulk(t, wrapper, `{"mode": "atomic", "items": [
+		{"op": "create", "banner": {"tag_ids": [1], "feature_id": 2, "content": {}, "is_active": true}},
+		{"op": "create", "banner": {"tag_ids": [1], "feature_id": 2, "content": {}, "is_active": true}}
+	]}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.False(t, response.Applied)
+	assert.Equal(t, http.StatusFailedDependency, response.Results[0].Status)
+	assert.Nil(t, response.Results[0].Id)
+	assert.Equal(t, http.StatusConflict, response.Results[1].Status)
+	assert.Equal(t, []FieldError{{Field: "tag_ids", Message: "banner 10 already has feature 2, tag 1 and priority 0"}}, *response.Results[1].Fields)
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerBulkBestEffort(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	expectCreateBanner(db_mock, 2, []int{1}, 0, 10)
+	db_mock.ExpectCommit()
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT tag_ids, feature_id, content, is_active, priority, revision FROM banners WHERE id = $1 AND deleted_at IS NULL FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"tag_ids", "feature_id", "content", "is_active", "priority", "revision"}))
+	db_mock.ExpectRollback()
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT tag_ids, feature_id, content, is_active, priority, revision FROM banners WHERE id = $1 AND deleted_at IS NULL FOR UPDATE").
+		WithArgs(8).
+		WillReturnError(errors.New("connection reset"))
+	db_mock.ExpectRollback()
+	db_mock.ExpectBegin()
+	expectCreateBanner(db_mock, 2, []int{3}, 0, 11)
+	db_mock.ExpectCommit()
+
+	rec, response := postBannerBulk(t, wrapper, `{"mode": "best_effort", "items": [
+		{"op": "create", "banner": {"tag_ids": [1], "feature_id": 2, "content": {}, "is_active": true}},
+		{"op": "create", "banner": {"tag_ids": [1], "feature_id": 2, "is_active": true}},
+		{"op": "patch", "id": 7, "banner": {"is_active": false}},
+		{"op": "patch", "id": 8, "banner": {"is_active": false}},
+		{"op": "create", "banner": {"tag_ids": [3], "feature_id": 2, "content": {}, "is_active": true}}
+	]}`)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, response.Applied)
+	assert.Equal(t, http.StatusCreated, response.Results[0].Status)
+	assert.Equal(t, http.StatusBadRequest, response.Results[1].Status)
+	assert.Equal(t, []FieldError{{Field: "banner.content", Message: "is required"}}, *response.Results[1].Fields)
+	assert.Equal(t, http.StatusNotFound, response.Results[2].Status)
+	assert.Equal(t, http.StatusInternalServerError, response.Results[3].Status)
+	assert.Equal(t, "connection reset", *response.Results[3].Error)
+	assert.Equal(t, http.StatusCreated, response.Results[4].Status)
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerConflict(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	expectCreateBanner(db_mock, 2, []int{1}, 10, 0)
+	db_mock.ExpectRollback()
+
+	e := echo.New()
+	body := `{"tag_ids": [1], "feature_id": 2, "content": {}, "is_active": true}`
+	req := httptest.NewRequest(http.MethodPost, "/banner", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBanner(c)) {
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestLockBulkItems(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+
+	// Rows of patched banners are locked first, then features of created banners, patched banners and their new features,
+	// all in ascending order
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT id, feature_id FROM banners WHERE id = ANY($1::int[]) ORDER BY id FOR UPDATE").
+		WithArgs(pq.Array([]int{7, 8})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "feature_id"}).AddRow(7, 4).AddRow(8, 1))
+	for _, featureID := range []int{1, 2, 3, 4, 5} {
+		expectFeatureLock(db_mock, featureID)
+	}
+	db_mock.ExpectRollback()
+
+	items := []bannerBulkItem{
+		{op: Create, input: BannerInput{FeatureId: 5}},
+		{op: Patch, id: 8, patch: []byte(`{"is_active": false}`)},
+		{op: Patch, id: 7, patch: []byte(`{"feature_id": 3}`)},
+		{op: Create, input: BannerInput{FeatureId: 2}},
+		{op: Patch, id: 8, patch: []byte(`{"priority": 2}`)},
+		{op: Create, input: BannerInput{FeatureId: 5}},
+	}
+
+	tx, err := server.db.Begin()
+
+	if err != nil {
+		t.Fatalf("Error occcured: %s", err.Error())
+	}
+	assert.NoError(t, lockBulkItems(tx, items))
+	tx.Rollback()
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestLockBulkItemsOpposingBatches(t *testing.T) {
+	// Two atomic requests patch the same banners in opposite order at the same time.
+	// Both lock rows and features in the same order, so neither waits for a lock the other holds
+	batches := [][]bannerBulkItem{
+		{{op: Patch, id: 7, patch: []byte(`{"priority": 2}`)}, {op: Patch, id: 8, patch: []byte(`{"priority": 2}`)}},
+		{{op: Patch, id: 8, patch: []byte(`{"priority": 3}`)}, {op: Patch, id: 7, patch: []byte(`{"priority": 3}`)}},
+	}
+
+	var wg sync.WaitGroup
+	for _, items := range batches {
+		server, db_mock, _ := newTestServer(t)
+		db_mock.ExpectBegin()
+		db_mock.ExpectQuery("SELECT id, feature_id FROM banners WHERE id = ANY($1::int[]) ORDER BY id FOR UPDATE").
+			WithArgs(pq.Array([]int{7, 8})).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "feature_id"}).AddRow(7, 4).AddRow(8, 2))
+		expectFeatureLock(db_mock, 2)
+		expectFeatureLock(db_mock, 4)
+		db_mock.ExpectRollback()
+
+		wg.Add(1)
+		go func(items []bannerBulkItem) {
+			defer wg.Done()
+
+			tx, err := server.db.Begin()
+
+			if err != nil {
+				t.Errorf("Error occcured: %s", err.Error())
+				return
+			}
+			assert.NoError(t, lockBulkItems(tx, items))
+			tx.Rollback()
+
+			if err := db_mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		}(items)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+// Rejected banner write. Status is the HTTP status single banner handlers answer with
+type bannerWriteError struct {
+	status  int
+	message string
+	fields  []FieldError
+}
+
+// Writes error response the way single banner handlers always did
+func (e *bannerWriteError) respond(ctx echo.Context) error {
+	if e.fields != nil {
+		return ctx.JSON(e.status, ValidationError{Error: e.message, Fields: e.fields})
+	}
+	if e.status == http.StatusUnsupportedMediaType {
+		return ctx.JSON(e.status, e.message)
+	}
+	return ctx.HTML(e.status, e.message)
+}
+
+// Builds write error for failed validation
+func invalidBannerError(fields []FieldError) *bannerWriteError {
+	return &bannerWriteError{status: http.StatusBadRequest, message: validationError(fields).Error, fields: fields}
+}
+
+// Serializes banner writes of one feature until the end of transaction, so uniqueness check and write can't interleave
+func lockFeature(tx *sql.Tx, featureID int) error {
+	_, err := tx.Exec("SELECT pg_advisory_xact_lock('banners'::regclass::oid::int, $1)", featureID)
+	return err
+}
+
+// Checks that no other banner has the same feature, priority and one of the tags. Feature has to be locked by lockFeature
+func checkBannerUnique(tx *sql.Tx, excludeID int, input BannerInput) (*bannerWriteError, error) {
+	var id, tagID int
+	query := `SELECT id, (SELECT t FROM unnest(tag_ids) AS t WHERE t = ANY($3::int[]) ORDER BY t LIMIT 1)
+	FROM banners
+	WHERE feature_id = $1 AND priority = $2 AND tag_ids && $3::int[] AND id <> $4 AND deleted_at IS NULL
+	ORDER BY id
+	LIMIT 1`
+	err := tx.QueryRow(query, input.FeatureId, bannerPriority(input), pq.Array(input.TagIds), excludeID).Scan(&id, &tagID)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("banner %d already has feature %d, tag %d and priority %d", id, input.FeatureId, tagID, bannerPriority(input))
+	return &bannerWriteError{
+		status:  http.StatusConflict,
+		message: "banner conflicts with existing banner",
+		fields:  []FieldError{{Field: "tag_ids", Message: message}},
+	}, nil
+}
+
+// Checks that feature and tags of banner exist and are not archived. Only references old banner doesn't have yet are checked,
+// so banners keep their archived feature and tags. Checked rows stay locked until the end of transaction, so they can't be archived or deleted meanwhile
+func checkBannerRefs(tx *sql.Tx, input BannerInput, old *Banner) ([]FieldError, error) {
+	var errs []FieldError
+
+	if old == nil || old.FeatureID != input.FeatureId {
+		var archived bool
+		err := tx.QueryRow("SELECT archived FROM features WHERE id = $1 FOR SHARE", input.FeatureId).Scan(&archived)
+
+		if err == sql.ErrNoRows {
+			errs = append(errs, FieldError{Field: "feature_id", Message: fmt.Sprintf("feature %d does not exist", input.FeatureId)})
+		} else if err != nil {
+			return nil, err
+		} else if archived {
+			errs = append(errs, FieldError{Field: "feature_id", Message: fmt.Sprintf("feature %d is archived", input.FeatureId)})
+		}
+	}
+
+	oldTags := map[int]bool{}
+	if old != nil {
+		for _, tagID := range old.TagIDs {
+			oldTags[int(tagID)] = true
+		}
+	}
+
+	var newTags []int
+	for _, tagID := range input.TagIds {
+		if !oldTags[tagID] {
+			newTags = append(newTags, tagID)
+		}
+	}
+	if len(newTags) == 0 {
+		return errs, nil
+	}
+
+	rows, err := tx.Query("SELECT id, archived FROM tags WHERE id = ANY($1::int[]) FOR SHARE", pq.Array(newTags))
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	archivedTags := map[int]bool{}
+	for rows.Next() {
+		var id int
+		var archived bool
+		if err := rows.Scan(&id, &archived); err != nil {
+			return nil, err
+		}
+		archivedTags[id] = archived
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, tagID := range input.TagIds {
+		if oldTags[tagID] {
+			continue
+		}
+		field := fmt.Sprintf("tag_ids[%d]", i)
+		archived, ok := archivedTags[tagID]
+
+		if !ok {
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("tag %d does not exist", tagID)})
+		} else if archived {
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("tag %d is archived", tagID)})
+		}
+	}
+	return errs, nil
+}
+
+// Serializes banner writes of several features, features are locked in ascending order to avoid deadlocks
+func lockFeatures(tx *sql.Tx, featureIDs ...int) error {
+	sorted := append([]int(nil), featureIDs...)
+	sort.Ints(sorted)
+
+	for _, featureID := range uniqueIDs(sorted) {
+		if err := lockFeature(tx, featureID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Creates validated banner inside tx. Returns id of the new banner
+func createBanner(tx *sql.Tx, input BannerInput, audit auditSource) (int, *bannerWriteError, error) {
+	return createBannerWithID(tx, 0, input, audit)
+}
+
+// Creates validated banner with given id inside tx, zero id lets the database pick it, and logs it to audit.
+// Returns id of the new banner
+func createBannerWithID(tx *sql.Tx, id int, input BannerInput, audit auditSource) (int, *bannerWriteError, error) {
+	contentJSON, err := json.Marshal(input.Content)
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	fieldErrs, err := checkBannerRefs(tx, input, nil)
+
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(fieldErrs) > 0 {
+		return 0, invalidBannerError(fieldErrs), nil
+	}
+
+	fieldErrs, err = checkBannerContent(tx, input)
+
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(fieldErrs) > 0 {
+		return 0, invalidBannerError(fieldErrs), nil
+	}
+	if err := lockFeature(tx, input.FeatureId); err != nil {
+		return 0, nil, err
+	}
+
+	writeErr, err := checkBannerUnique(tx, id, input)
+
+	if err != nil || writeErr != nil {
+		return 0, writeErr, err
+	}
+
+	if id != 0 {
+		query := `INSERT INTO banners (id, content, feature_id, tag_ids, is_active, priority) VALUES ($1, $2, $3, $4, $5, $6)`
+		_, err = tx.Exec(query, id, contentJSON, input.FeatureId, pq.Array(input.TagIds), input.IsActive, bannerPriority(input))
+	} else {
+		query := `INSERT INTO banners (content, feature_id, tag_ids, is_active, priority) VALUES ($1, $2, $3, $4, $5) RETURNING id`
+		err = tx.QueryRow(query, contentJSON, input.FeatureId, pq.Array(input.TagIds), input.IsActive, bannerPriority(input)).Scan(&id)
+	}
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	document, err := bannerInputDocument(input, contentJSON)
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	diff, err := auditDiff(nil, document)
+
+	if err != nil {
+		return 0, nil, err
+	}
+	return id, nil, recordBannerChange(tx, audit, auditCreate, id, diff)
+}
+
+// Applies PATCH body to banner inside tx. Returns revision of the banner after the patch
+func patchBanner(tx *sql.Tx, id int, ifMatch *string, contentType string, data []byte, audit auditSource) (int64, *bannerWriteError, error) {
+	var banner Banner
+	query := "SELECT tag_ids, feature_id, content, is_active, priority, revision FROM banners WHERE id = $1 AND deleted_at IS NULL FOR UPDATE"
+	err := tx.QueryRow(query, id).Scan(pq.Array(&banner.TagIDs), &banner.FeatureID, &banner.Content, &banner.IsActive, &banner.Priority, &banner.Revision)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, &bannerWriteError{status: http.StatusNotFound, message: "Баннер не найден"}, nil
+		} else {
+			return 0, nil, err
+		}
+	}
+	if ifMatch != nil && !etagMatches(*ifMatch, bannerETag(banner.Revision)) {
+		return 0, &bannerWriteError{status: http.StatusPreconditionFailed, message: "Баннер был изменён"}, nil
+	}
+
+	current, err := bannerDocument(banner)
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	patched, fields, fieldErrs, err := applyBannerPatch(current, contentType, data)
+
+	if err != nil {
+		return 0, &bannerWriteError{status: http.StatusUnsupportedMediaType, message: err.Error()}, nil
+	}
+	if len(fieldErrs) > 0 {
+		return 0, invalidBannerError(fieldErrs), nil
+	}
+
+	input, fieldErrs := decodeBannerInput(patched)
+
+	if len(fieldErrs) > 0 {
+		return 0, invalidBannerError(fieldErrs), nil
+	}
+	if len(fields) == 0 {
+		return banner.Revision, nil, nil
+	}
+
+	// Only references the banner doesn't have yet are checked, so unchanged references cost no queries
+	fieldErrs, err = checkBannerRefs(tx, input, &banner)
+
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(fieldErrs) > 0 {
+		return 0, invalidBannerError(fieldErrs), nil
+	}
+
+	// New feature may have another schema, so content is checked when either of them changes
+	if changesBannerContent(fields) {
+		fieldErrs, err := checkBannerContent(tx, input)
+
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(fieldErrs) > 0 {
+			return 0, invalidBannerError(fieldErrs), nil
+		}
+	}
+
+	if changesBannerKey(fields) {
+		// Both the old and the new feature are locked
+		if err := lockFeatures(tx, banner.FeatureID, input.FeatureId); err != nil {
+			return 0, nil, err
+		}
+
+		writeErr, err := checkBannerUnique(tx, id, input)
+
+		if err != nil || writeErr != nil {
+			return 0, writeErr, err
+		}
+	}
+
+	contentJSON, err := json.Marshal(input.Content)
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Update succeeds only if nobody changed the banner since it was read above
+	var revision int64
+	query, args := patchBannerQueryBuilder(id, banner.Revision, fields, input, contentJSON)
+	err = tx.QueryRow(query, args...).Scan(&revision)
+
+	if err != nil {
+		if err == sql.ErrNoRows && ifMatch != nil {
+			return 0, &bannerWriteError{status: http.StatusPreconditionFailed, message: "Баннер был изменён"}, nil
+		} else if err == sql.ErrNoRows {
+			return 0, &bannerWriteError{status: http.StatusConflict, message: "Баннер был изменён параллельным запросом"}, nil
+		} else {
+			return 0, nil, err
+		}
+	}
+
+	document, err := bannerInputDocument(input, contentJSON)
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	diff, err := auditDiff(current, document)
+
+	if err != nil {
+		return 0, nil, err
+	}
+	return revision, nil, recordBannerChange(tx, audit, auditUpdate, id, diff)
+}
+
+// Moves banner to trash inside tx and logs it to audit. Returns feature of the published revision, its users lose the banner
+func deleteBanner(tx *sql.Tx, id int, ifMatch *string, audit auditSource) ([]int, *bannerWriteError, error) {
+	var deletedAt time.Time
+	var publishedFeatureID sql.NullInt64
+	query := "UPDATE banners SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL RETURNING deleted_at, published_feature_id"
+	args := []interface{}{id}
+
+	if ifMatch != nil {
+		var revision int64
+		err := tx.QueryRow("SELECT revision FROM banners WHERE id = $1 AND deleted_at IS NULL", id).Scan(&revision)
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, &bannerWriteError{status: http.StatusNotFound, message: "Баннер не найден"}, nil
+			} else {
+				return nil, nil, err
+			}
+		}
+		if !etagMatches(*ifMatch, bannerETag(revision)) {
+			return nil, &bannerWriteError{status: http.StatusPreconditionFailed, message: "Баннер был изменён"}, nil
+		}
+
+		query = "UPDATE banners SET deleted_at = now() WHERE id = $1 AND revision = $2 AND deleted_at IS NULL RETURNING deleted_at, published_feature_id"
+		args = append(args, revision)
+	}
+
+	err := tx.QueryRow(query, args...).Scan(&deletedAt, &publishedFeatureID)
+
+	if err != nil {
+		if err == sql.ErrNoRows && ifMatch != nil {
+			return nil, &bannerWriteError{status: http.StatusPreconditionFailed, message: "Баннер был изменён"}, nil
+		} else if err == sql.ErrNoRows {
+			return nil, &bannerWriteError{status: http.StatusNotFound, message: "Баннер не найден"}, nil
+		} else {
+			return nil, nil, err
+		}
+	}
+
+	var featureIDs []int
+	if publishedFeatureID.Valid {
+		featureIDs = append(featureIDs, int(publishedFeatureID.Int64))
+	}
+	return featureIDs, nil, recordBannerChange(tx, audit, auditDelete, id, auditFieldDiff("deleted_at", nil, deletedAt))
+}
+
+// Checks if patched fields take part in banner uniqueness
+func changesBannerKey(fields []string) bool {
+	for _, field := range fields {
+		if field == "tag_ids" || field == "feature_id" || field == "priority" {
+			return true
+		}
+	}
+	return false
+}
+
+// Checks if patched fields may change how banner content conforms to the feature schema
+func changesBannerContent(fields []string) bool {
+	for _, field := range fields {
+		if field == "content" || field == "feature_id" {
+			return true
+		}
+	}
+	return false
+}
+
+// Runs fn in a transaction. Transaction is committed only if fn neither failed nor rejected the write
+func (s *Server) writeInTx(fn func(tx *sql.Tx) (*bannerWriteError, error)) (*bannerWriteError, error) {
+	tx, err := s.db.Begin()
+
+	if err != nil {
+		return nil, err
+	}
+
+	writeErr, err := fn(tx)
+
+	if err != nil || writeErr != nil {
+		tx.Rollback()
+		return writeErr, err
+	}
+	return nil, tx.Commit()
+}
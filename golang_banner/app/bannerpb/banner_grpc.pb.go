@@ -0,0 +1,1785 @@
+// gRPC API баннеров для сервисов, которым не нужна обёртка HTTP. В нём есть все операции REST API из api.yaml,
+// методы вызывают те же функции, что и обработчики REST API, поэтому авторизация, проверки, аудит и кэш у них общие.
+// Выгрузка приходит потоком баннеров, а не файлом JSON Lines или CSV.
+//
+// Токен передаётся в метаданных token, идентификатор запроса - в x-request-id. Без x-request-id сервер создаёт его сам
+// и возвращает в заголовке ответа x-request-id.
+//
+// Ошибки передаются статусами gRPC: некорректный запрос - INVALID_ARGUMENT, нет токена или он неизвестен - UNAUTHENTICATED,
+// нет прав - PERMISSION_DENIED, баннер не найден - NOT_FOUND, конфликт, устаревший if_match или его отсутствие,
+// когда он обязателен - FAILED_PRECONDITION, остальные - INTERNAL. Ошибки проверки полей приходят в деталях
+// как google.rpc.BadRequest с именами полей из api.yaml.
+//
+// Код генерируется командой из корня модуля:
+//   protoc -I bannerpb --go_out=bannerpb --go_opt=paths=source_relative \
+//     --go-grpc_out=bannerpb --go-grpc_opt=paths=source_relative bannerpb/banner.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: banner.proto
+
+package bannerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BannerService_GetUserBanner_FullMethodName            = "/banner.v1.BannerService/GetUserBanner"
+	BannerService_WatchUserBanner_FullMethodName          = "/banner.v1.BannerService/WatchUserBanner"
+	BannerService_ListBanners_FullMethodName              = "/banner.v1.BannerService/ListBanners"
+	BannerService_GetBanner_FullMethodName                = "/banner.v1.BannerService/GetBanner"
+	BannerService_CreateBanner_FullMethodName             = "/banner.v1.BannerService/CreateBanner"
+	BannerService_PatchBanner_FullMethodName              = "/banner.v1.BannerService/PatchBanner"
+	BannerService_DeleteBanner_FullMethodName             = "/banner.v1.BannerService/DeleteBanner"
+	BannerService_SubmitBanner_FullMethodName             = "/banner.v1.BannerService/SubmitBanner"
+	BannerService_ApproveBanner_FullMethodName            = "/banner.v1.BannerService/ApproveBanner"
+	BannerService_RejectBanner_FullMethodName             = "/banner.v1.BannerService/RejectBanner"
+	BannerService_PublishBanner_FullMethodName            = "/banner.v1.BannerService/PublishBanner"
+	BannerService_ArchiveBanner_FullMethodName            = "/banner.v1.BannerService/ArchiveBanner"
+	BannerService_GetUserBannerBatch_FullMethodName       = "/banner.v1.BannerService/GetUserBannerBatch"
+	BannerService_ListBannerConflicts_FullMethodName      = "/banner.v1.BannerService/ListBannerConflicts"
+	BannerService_BulkBanners_FullMethodName              = "/banner.v1.BannerService/BulkBanners"
+	BannerService_BulkDeleteBanners_FullMethodName        = "/banner.v1.BannerService/BulkDeleteBanners"
+	BannerService_GetJob_FullMethodName                   = "/banner.v1.BannerService/GetJob"
+	BannerService_ExportBanners_FullMethodName            = "/banner.v1.BannerService/ExportBanners"
+	BannerService_ImportBanners_FullMethodName            = "/banner.v1.BannerService/ImportBanners"
+	BannerService_ListTrash_FullMethodName                = "/banner.v1.BannerService/ListTrash"
+	BannerService_RestoreBanner_FullMethodName            = "/banner.v1.BannerService/RestoreBanner"
+	BannerService_ListFeatures_FullMethodName             = "/banner.v1.BannerService/ListFeatures"
+	BannerService_GetFeature_FullMethodName               = "/banner.v1.BannerService/GetFeature"
+	BannerService_CreateFeature_FullMethodName            = "/banner.v1.BannerService/CreateFeature"
+	BannerService_UpdateFeature_FullMethodName            = "/banner.v1.BannerService/UpdateFeature"
+	BannerService_DeleteFeature_FullMethodName            = "/banner.v1.BannerService/DeleteFeature"
+	BannerService_GetFeatureSchema_FullMethodName         = "/banner.v1.BannerService/GetFeatureSchema"
+	BannerService_SetFeatureSchema_FullMethodName         = "/banner.v1.BannerService/SetFeatureSchema"
+	BannerService_DeleteFeatureSchema_FullMethodName      = "/banner.v1.BannerService/DeleteFeatureSchema"
+	BannerService_ListTags_FullMethodName                 = "/banner.v1.BannerService/ListTags"
+	BannerService_GetTag_FullMethodName                   = "/banner.v1.BannerService/GetTag"
+	BannerService_CreateTag_FullMethodName                = "/banner.v1.BannerService/CreateTag"
+	BannerService_UpdateTag_FullMethodName                = "/banner.v1.BannerService/UpdateTag"
+	BannerService_DeleteTag_FullMethodName                = "/banner.v1.BannerService/DeleteTag"
+	BannerService_ListWebhooks_FullMethodName             = "/banner.v1.BannerService/ListWebhooks"
+	BannerService_GetWebhook_FullMethodName               = "/banner.v1.BannerService/GetWebhook"
+	BannerService_CreateWebhook_FullMethodName            = "/banner.v1.BannerService/CreateWebhook"
+	BannerService_UpdateWebhook_FullMethodName            = "/banner.v1.BannerService/UpdateWebhook"
+	BannerService_DeleteWebhook_FullMethodName            = "/banner.v1.BannerService/DeleteWebhook"
+	BannerService_ListDeadLetters_FullMethodName          = "/banner.v1.BannerService/ListDeadLetters"
+	BannerService_RedeliverWebhookDelivery_FullMethodName = "/banner.v1.BannerService/RedeliverWebhookDelivery"
+	BannerService_ListAudit_FullMethodName                = "/banner.v1.BannerService/ListAudit"
+	BannerService_GetReadiness_FullMethodName             = "/banner.v1.BannerService/GetReadiness"
+)
+
+// BannerServiceClient is the client API for BannerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BannerServiceClient interface {
+	// Баннер пользователя, как GET /user_banner
+	GetUserBanner(ctx context.Context, in *GetUserBannerRequest, opts ...grpc.CallOption) (*UserBanner, error)
+	// Баннер пользователя сразу после подключения и после каждого изменения, как GET /user_banner/stream
+	WatchUserBanner(ctx context.Context, in *WatchUserBannerRequest, opts ...grpc.CallOption) (BannerService_WatchUserBannerClient, error)
+	// Страница баннеров, как GET /banner
+	ListBanners(ctx context.Context, in *ListBannersRequest, opts ...grpc.CallOption) (*BannerPage, error)
+	GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*Banner, error)
+	CreateBanner(ctx context.Context, in *CreateBannerRequest, opts ...grpc.CallOption) (*CreateBannerResponse, error)
+	PatchBanner(ctx context.Context, in *PatchBannerRequest, opts ...grpc.CallOption) (*BannerRevision, error)
+	// Перемещает баннер в корзину
+	DeleteBanner(ctx context.Context, in *DeleteBannerRequest, opts ...grpc.CallOption) (*DeleteBannerResponse, error)
+	// Переходы баннера между состояниями, как POST /banner/{id}/submit и остальные
+	SubmitBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error)
+	ApproveBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error)
+	// Требует comment
+	RejectBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error)
+	PublishBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error)
+	ArchiveBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error)
+	// Несколько баннеров пользователя, как POST /user_banner/batch
+	GetUserBannerBatch(ctx context.Context, in *UserBannerBatchRequest, opts ...grpc.CallOption) (*UserBannerBatchResponse, error)
+	// Пары фича-тэг, которые покрывают несколько опубликованных баннеров, как GET /banner/conflicts
+	ListBannerConflicts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BannerConflictList, error)
+	// Массовое создание и изменение баннеров, как POST /banner/bulk
+	BulkBanners(ctx context.Context, in *BulkBannersRequest, opts ...grpc.CallOption) (*BulkBannersResponse, error)
+	// Массовое удаление баннеров фичи и/или тэга фоновой задачей, как DELETE /banner
+	BulkDeleteBanners(ctx context.Context, in *BulkDeleteBannersRequest, opts ...grpc.CallOption) (*JobAccepted, error)
+	// Состояние фоновой задачи, как GET /jobs/{id}
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// Выгрузка баннеров, как GET /banner/export, по баннеру в сообщении
+	ExportBanners(ctx context.Context, in *ExportBannersRequest, opts ...grpc.CallOption) (BannerService_ExportBannersClient, error)
+	// Загрузка баннеров, как POST /banner/import. Если не прошла хотя бы одна запись, applied равен false
+	ImportBanners(ctx context.Context, in *ImportBannersRequest, opts ...grpc.CallOption) (*BannerImportReport, error)
+	// Удалённые баннеры, как GET /banner/trash
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*BannerList, error)
+	RestoreBanner(ctx context.Context, in *RestoreBannerRequest, opts ...grpc.CallOption) (*BannerRevision, error)
+	// Справочник фич, как /features
+	ListFeatures(ctx context.Context, in *ListCatalogRequest, opts ...grpc.CallOption) (*CatalogEntryList, error)
+	GetFeature(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error)
+	CreateFeature(ctx context.Context, in *CatalogEntryInput, opts ...grpc.CallOption) (*CatalogEntry, error)
+	UpdateFeature(ctx context.Context, in *UpdateCatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error)
+	// Удаляет фичу, на которую не ссылаются баннеры
+	DeleteFeature(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// JSON Schema содержимого баннеров фичи, как /features/{id}/schema
+	GetFeatureSchema(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*FeatureSchema, error)
+	// Сохраняет схему и запускает задачу проверки баннеров фичи
+	SetFeatureSchema(ctx context.Context, in *SetFeatureSchemaRequest, opts ...grpc.CallOption) (*JobAccepted, error)
+	DeleteFeatureSchema(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Справочник тэгов, как /tags
+	ListTags(ctx context.Context, in *ListCatalogRequest, opts ...grpc.CallOption) (*CatalogEntryList, error)
+	GetTag(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error)
+	CreateTag(ctx context.Context, in *CatalogEntryInput, opts ...grpc.CallOption) (*CatalogEntry, error)
+	UpdateTag(ctx context.Context, in *UpdateCatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error)
+	DeleteTag(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Подписки на изменения баннеров, как /webhooks
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*WebhookList, error)
+	GetWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Секрет возвращается только в ответе на создание
+	CreateWebhook(ctx context.Context, in *WebhookInput, opts ...grpc.CallOption) (*Webhook, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Недоставленные события подписки, как GET /webhooks/{id}/dead-letters
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*WebhookDeliveryList, error)
+	// Повторная отправка недоставленного события
+	RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	// Журнал аудита, как GET /audit
+	ListAudit(ctx context.Context, in *ListAuditRequest, opts ...grpc.CallOption) (*AuditPage, error)
+	// Готовность сервиса, как GET /readyz. Токен не нужен
+	GetReadiness(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Readiness, error)
+}
+
+type bannerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBannerServiceClient(cc grpc.ClientConnInterface) BannerServiceClient {
+	return &bannerServiceClient{cc}
+}
+
+func (c *bannerServiceClient) GetUserBanner(ctx context.Context, in *GetUserBannerRequest, opts ...grpc.CallOption) (*UserBanner, error) {
+	out := new(UserBanner)
+	err := c.cc.Invoke(ctx, BannerService_GetUserBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) WatchUserBanner(ctx context.Context, in *WatchUserBannerRequest, opts ...grpc.CallOption) (BannerService_WatchUserBannerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BannerService_ServiceDesc.Streams[0], BannerService_WatchUserBanner_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bannerServiceWatchUserBannerClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BannerService_WatchUserBannerClient interface {
+	Recv() (*UserBannerEvent, error)
+	grpc.ClientStream
+}
+
+type bannerServiceWatchUserBannerClient struct {
+	grpc.ClientStream
+}
+
+func (x *bannerServiceWatchUserBannerClient) Recv() (*UserBannerEvent, error) {
+	m := new(UserBannerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bannerServiceClient) ListBanners(ctx context.Context, in *ListBannersRequest, opts ...grpc.CallOption) (*BannerPage, error) {
+	out := new(BannerPage)
+	err := c.cc.Invoke(ctx, BannerService_ListBanners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*Banner, error) {
+	out := new(Banner)
+	err := c.cc.Invoke(ctx, BannerService_GetBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) CreateBanner(ctx context.Context, in *CreateBannerRequest, opts ...grpc.CallOption) (*CreateBannerResponse, error) {
+	out := new(CreateBannerResponse)
+	err := c.cc.Invoke(ctx, BannerService_CreateBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) PatchBanner(ctx context.Context, in *PatchBannerRequest, opts ...grpc.CallOption) (*BannerRevision, error) {
+	out := new(BannerRevision)
+	err := c.cc.Invoke(ctx, BannerService_PatchBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) DeleteBanner(ctx context.Context, in *DeleteBannerRequest, opts ...grpc.CallOption) (*DeleteBannerResponse, error) {
+	out := new(DeleteBannerResponse)
+	err := c.cc.Invoke(ctx, BannerService_DeleteBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) SubmitBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error) {
+	out := new(BannerRevision)
+	err := c.cc.Invoke(ctx, BannerService_SubmitBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ApproveBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error) {
+	out := new(BannerRevision)
+	err := c.cc.Invoke(ctx, BannerService_ApproveBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) RejectBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error) {
+	out := new(BannerRevision)
+	err := c.cc.Invoke(ctx, BannerService_RejectBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) PublishBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error) {
+	out := new(BannerRevision)
+	err := c.cc.Invoke(ctx, BannerService_PublishBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ArchiveBanner(ctx context.Context, in *BannerTransitionRequest, opts ...grpc.CallOption) (*BannerRevision, error) {
+	out := new(BannerRevision)
+	err := c.cc.Invoke(ctx, BannerService_ArchiveBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) GetUserBannerBatch(ctx context.Context, in *UserBannerBatchRequest, opts ...grpc.CallOption) (*UserBannerBatchResponse, error) {
+	out := new(UserBannerBatchResponse)
+	err := c.cc.Invoke(ctx, BannerService_GetUserBannerBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ListBannerConflicts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BannerConflictList, error) {
+	out := new(BannerConflictList)
+	err := c.cc.Invoke(ctx, BannerService_ListBannerConflicts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) BulkBanners(ctx context.Context, in *BulkBannersRequest, opts ...grpc.CallOption) (*BulkBannersResponse, error) {
+	out := new(BulkBannersResponse)
+	err := c.cc.Invoke(ctx, BannerService_BulkBanners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) BulkDeleteBanners(ctx context.Context, in *BulkDeleteBannersRequest, opts ...grpc.CallOption) (*JobAccepted, error) {
+	out := new(JobAccepted)
+	err := c.cc.Invoke(ctx, BannerService_BulkDeleteBanners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, BannerService_GetJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ExportBanners(ctx context.Context, in *ExportBannersRequest, opts ...grpc.CallOption) (BannerService_ExportBannersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BannerService_ServiceDesc.Streams[1], BannerService_ExportBanners_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bannerServiceExportBannersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BannerService_ExportBannersClient interface {
+	Recv() (*Banner, error)
+	grpc.ClientStream
+}
+
+type bannerServiceExportBannersClient struct {
+	grpc.ClientStream
+}
+
+func (x *bannerServiceExportBannersClient) Recv() (*Banner, error) {
+	m := new(Banner)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bannerServiceClient) ImportBanners(ctx context.Context, in *ImportBannersRequest, opts ...grpc.CallOption) (*BannerImportReport, error) {
+	out := new(BannerImportReport)
+	err := c.cc.Invoke(ctx, BannerService_ImportBanners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*BannerList, error) {
+	out := new(BannerList)
+	err := c.cc.Invoke(ctx, BannerService_ListTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) RestoreBanner(ctx context.Context, in *RestoreBannerRequest, opts ...grpc.CallOption) (*BannerRevision, error) {
+	out := new(BannerRevision)
+	err := c.cc.Invoke(ctx, BannerService_RestoreBanner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ListFeatures(ctx context.Context, in *ListCatalogRequest, opts ...grpc.CallOption) (*CatalogEntryList, error) {
+	out := new(CatalogEntryList)
+	err := c.cc.Invoke(ctx, BannerService_ListFeatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) GetFeature(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, BannerService_GetFeature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) CreateFeature(ctx context.Context, in *CatalogEntryInput, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, BannerService_CreateFeature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) UpdateFeature(ctx context.Context, in *UpdateCatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, BannerService_UpdateFeature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) DeleteFeature(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BannerService_DeleteFeature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) GetFeatureSchema(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*FeatureSchema, error) {
+	out := new(FeatureSchema)
+	err := c.cc.Invoke(ctx, BannerService_GetFeatureSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) SetFeatureSchema(ctx context.Context, in *SetFeatureSchemaRequest, opts ...grpc.CallOption) (*JobAccepted, error) {
+	out := new(JobAccepted)
+	err := c.cc.Invoke(ctx, BannerService_SetFeatureSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) DeleteFeatureSchema(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BannerService_DeleteFeatureSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ListTags(ctx context.Context, in *ListCatalogRequest, opts ...grpc.CallOption) (*CatalogEntryList, error) {
+	out := new(CatalogEntryList)
+	err := c.cc.Invoke(ctx, BannerService_ListTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) GetTag(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, BannerService_GetTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) CreateTag(ctx context.Context, in *CatalogEntryInput, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, BannerService_CreateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) UpdateTag(ctx context.Context, in *UpdateCatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, BannerService_UpdateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) DeleteTag(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BannerService_DeleteTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*WebhookList, error) {
+	out := new(WebhookList)
+	err := c.cc.Invoke(ctx, BannerService_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) GetWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, BannerService_GetWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) CreateWebhook(ctx context.Context, in *WebhookInput, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, BannerService_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, BannerService_UpdateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BannerService_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*WebhookDeliveryList, error) {
+	out := new(WebhookDeliveryList)
+	err := c.cc.Invoke(ctx, BannerService_ListDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, BannerService_RedeliverWebhookDelivery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) ListAudit(ctx context.Context, in *ListAuditRequest, opts ...grpc.CallOption) (*AuditPage, error) {
+	out := new(AuditPage)
+	err := c.cc.Invoke(ctx, BannerService_ListAudit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannerServiceClient) GetReadiness(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Readiness, error) {
+	out := new(Readiness)
+	err := c.cc.Invoke(ctx, BannerService_GetReadiness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BannerServiceServer is the server API for BannerService service.
+// All implementations must embed UnimplementedBannerServiceServer
+// for forward compatibility
+type BannerServiceServer interface {
+	// Баннер пользователя, как GET /user_banner
+	GetUserBanner(context.Context, *GetUserBannerRequest) (*UserBanner, error)
+	// Баннер пользователя сразу после подключения и после каждого изменения, как GET /user_banner/stream
+	WatchUserBanner(*WatchUserBannerRequest, BannerService_WatchUserBannerServer) error
+	// Страница баннеров, как GET /banner
+	ListBanners(context.Context, *ListBannersRequest) (*BannerPage, error)
+	GetBanner(context.Context, *GetBannerRequest) (*Banner, error)
+	CreateBanner(context.Context, *CreateBannerRequest) (*CreateBannerResponse, error)
+	PatchBanner(context.Context, *PatchBannerRequest) (*BannerRevision, error)
+	// Перемещает баннер в корзину
+	DeleteBanner(context.Context, *DeleteBannerRequest) (*DeleteBannerResponse, error)
+	// Переходы баннера между состояниями, как POST /banner/{id}/submit и остальные
+	SubmitBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error)
+	ApproveBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error)
+	// Требует comment
+	RejectBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error)
+	PublishBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error)
+	ArchiveBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error)
+	// Несколько баннеров пользователя, как POST /user_banner/batch
+	GetUserBannerBatch(context.Context, *UserBannerBatchRequest) (*UserBannerBatchResponse, error)
+	// Пары фича-тэг, которые покрывают несколько опубликованных баннеров, как GET /banner/conflicts
+	ListBannerConflicts(context.Context, *emptypb.Empty) (*BannerConflictList, error)
+	// Массовое создание и изменение баннеров, как POST /banner/bulk
+	BulkBanners(context.Context, *BulkBannersRequest) (*BulkBannersResponse, error)
+	// Массовое удаление баннеров фичи и/или тэга фоновой задачей, как DELETE /banner
+	BulkDeleteBanners(context.Context, *BulkDeleteBannersRequest) (*JobAccepted, error)
+	// Состояние фоновой задачи, как GET /jobs/{id}
+	GetJob(context.Context, *GetJobRequest) (*Job, error)
+	// Выгрузка баннеров, как GET /banner/export, по баннеру в сообщении
+	ExportBanners(*ExportBannersRequest, BannerService_ExportBannersServer) error
+	// Загрузка баннеров, как POST /banner/import. Если не прошла хотя бы одна запись, applied равен false
+	ImportBanners(context.Context, *ImportBannersRequest) (*BannerImportReport, error)
+	// Удалённые баннеры, как GET /banner/trash
+	ListTrash(context.Context, *ListTrashRequest) (*BannerList, error)
+	RestoreBanner(context.Context, *RestoreBannerRequest) (*BannerRevision, error)
+	// Справочник фич, как /features
+	ListFeatures(context.Context, *ListCatalogRequest) (*CatalogEntryList, error)
+	GetFeature(context.Context, *CatalogEntryRequest) (*CatalogEntry, error)
+	CreateFeature(context.Context, *CatalogEntryInput) (*CatalogEntry, error)
+	UpdateFeature(context.Context, *UpdateCatalogEntryRequest) (*CatalogEntry, error)
+	// Удаляет фичу, на которую не ссылаются баннеры
+	DeleteFeature(context.Context, *CatalogEntryRequest) (*emptypb.Empty, error)
+	// JSON Schema содержимого баннеров фичи, как /features/{id}/schema
+	GetFeatureSchema(context.Context, *CatalogEntryRequest) (*FeatureSchema, error)
+	// Сохраняет схему и запускает задачу проверки баннеров фичи
+	SetFeatureSchema(context.Context, *SetFeatureSchemaRequest) (*JobAccepted, error)
+	DeleteFeatureSchema(context.Context, *CatalogEntryRequest) (*emptypb.Empty, error)
+	// Справочник тэгов, как /tags
+	ListTags(context.Context, *ListCatalogRequest) (*CatalogEntryList, error)
+	GetTag(context.Context, *CatalogEntryRequest) (*CatalogEntry, error)
+	CreateTag(context.Context, *CatalogEntryInput) (*CatalogEntry, error)
+	UpdateTag(context.Context, *UpdateCatalogEntryRequest) (*CatalogEntry, error)
+	DeleteTag(context.Context, *CatalogEntryRequest) (*emptypb.Empty, error)
+	// Подписки на изменения баннеров, как /webhooks
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*WebhookList, error)
+	GetWebhook(context.Context, *WebhookRequest) (*Webhook, error)
+	// Секрет возвращается только в ответе на создание
+	CreateWebhook(context.Context, *WebhookInput) (*Webhook, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error)
+	DeleteWebhook(context.Context, *WebhookRequest) (*emptypb.Empty, error)
+	// Недоставленные события подписки, как GET /webhooks/{id}/dead-letters
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*WebhookDeliveryList, error)
+	// Повторная отправка недоставленного события
+	RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryRequest) (*WebhookDelivery, error)
+	// Журнал аудита, как GET /audit
+	ListAudit(context.Context, *ListAuditRequest) (*AuditPage, error)
+	// Готовность сервиса, как GET /readyz. Токен не нужен
+	GetReadiness(context.Context, *emptypb.Empty) (*Readiness, error)
+	mustEmbedUnimplementedBannerServiceServer()
+}
+
+// UnimplementedBannerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBannerServiceServer struct {
+}
+
+func (UnimplementedBannerServiceServer) GetUserBanner(context.Context, *GetUserBannerRequest) (*UserBanner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) WatchUserBanner(*WatchUserBannerRequest, BannerService_WatchUserBannerServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUserBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) ListBanners(context.Context, *ListBannersRequest) (*BannerPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBanners not implemented")
+}
+func (UnimplementedBannerServiceServer) GetBanner(context.Context, *GetBannerRequest) (*Banner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) CreateBanner(context.Context, *CreateBannerRequest) (*CreateBannerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) PatchBanner(context.Context, *PatchBannerRequest) (*BannerRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) DeleteBanner(context.Context, *DeleteBannerRequest) (*DeleteBannerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) SubmitBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) ApproveBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) RejectBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) PublishBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) ArchiveBanner(context.Context, *BannerTransitionRequest) (*BannerRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) GetUserBannerBatch(context.Context, *UserBannerBatchRequest) (*UserBannerBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBannerBatch not implemented")
+}
+func (UnimplementedBannerServiceServer) ListBannerConflicts(context.Context, *emptypb.Empty) (*BannerConflictList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBannerConflicts not implemented")
+}
+func (UnimplementedBannerServiceServer) BulkBanners(context.Context, *BulkBannersRequest) (*BulkBannersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkBanners not implemented")
+}
+func (UnimplementedBannerServiceServer) BulkDeleteBanners(context.Context, *BulkDeleteBannersRequest) (*JobAccepted, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteBanners not implemented")
+}
+func (UnimplementedBannerServiceServer) GetJob(context.Context, *GetJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedBannerServiceServer) ExportBanners(*ExportBannersRequest, BannerService_ExportBannersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBanners not implemented")
+}
+func (UnimplementedBannerServiceServer) ImportBanners(context.Context, *ImportBannersRequest) (*BannerImportReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBanners not implemented")
+}
+func (UnimplementedBannerServiceServer) ListTrash(context.Context, *ListTrashRequest) (*BannerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedBannerServiceServer) RestoreBanner(context.Context, *RestoreBannerRequest) (*BannerRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBanner not implemented")
+}
+func (UnimplementedBannerServiceServer) ListFeatures(context.Context, *ListCatalogRequest) (*CatalogEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatures not implemented")
+}
+func (UnimplementedBannerServiceServer) GetFeature(context.Context, *CatalogEntryRequest) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeature not implemented")
+}
+func (UnimplementedBannerServiceServer) CreateFeature(context.Context, *CatalogEntryInput) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeature not implemented")
+}
+func (UnimplementedBannerServiceServer) UpdateFeature(context.Context, *UpdateCatalogEntryRequest) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeature not implemented")
+}
+func (UnimplementedBannerServiceServer) DeleteFeature(context.Context, *CatalogEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeature not implemented")
+}
+func (UnimplementedBannerServiceServer) GetFeatureSchema(context.Context, *CatalogEntryRequest) (*FeatureSchema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeatureSchema not implemented")
+}
+func (UnimplementedBannerServiceServer) SetFeatureSchema(context.Context, *SetFeatureSchemaRequest) (*JobAccepted, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeatureSchema not implemented")
+}
+func (UnimplementedBannerServiceServer) DeleteFeatureSchema(context.Context, *CatalogEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeatureSchema not implemented")
+}
+func (UnimplementedBannerServiceServer) ListTags(context.Context, *ListCatalogRequest) (*CatalogEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedBannerServiceServer) GetTag(context.Context, *CatalogEntryRequest) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
+}
+func (UnimplementedBannerServiceServer) CreateTag(context.Context, *CatalogEntryInput) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedBannerServiceServer) UpdateTag(context.Context, *UpdateCatalogEntryRequest) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedBannerServiceServer) DeleteTag(context.Context, *CatalogEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedBannerServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*WebhookList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedBannerServiceServer) GetWebhook(context.Context, *WebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedBannerServiceServer) CreateWebhook(context.Context, *WebhookInput) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedBannerServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedBannerServiceServer) DeleteWebhook(context.Context, *WebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedBannerServiceServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*WebhookDeliveryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedBannerServiceServer) RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhookDelivery not implemented")
+}
+func (UnimplementedBannerServiceServer) ListAudit(context.Context, *ListAuditRequest) (*AuditPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudit not implemented")
+}
+func (UnimplementedBannerServiceServer) GetReadiness(context.Context, *emptypb.Empty) (*Readiness, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadiness not implemented")
+}
+func (UnimplementedBannerServiceServer) mustEmbedUnimplementedBannerServiceServer() {}
+
+// UnsafeBannerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BannerServiceServer will
+// result in compilation errors.
+type UnsafeBannerServiceServer interface {
+	mustEmbedUnimplementedBannerServiceServer()
+}
+
+func RegisterBannerServiceServer(s grpc.ServiceRegistrar, srv BannerServiceServer) {
+	s.RegisterService(&BannerService_ServiceDesc, srv)
+}
+
+func _BannerService_GetUserBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetUserBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetUserBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetUserBanner(ctx, req.(*GetUserBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_WatchUserBanner_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUserBannerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BannerServiceServer).WatchUserBanner(m, &bannerServiceWatchUserBannerServer{stream})
+}
+
+type BannerService_WatchUserBannerServer interface {
+	Send(*UserBannerEvent) error
+	grpc.ServerStream
+}
+
+type bannerServiceWatchUserBannerServer struct {
+	grpc.ServerStream
+}
+
+func (x *bannerServiceWatchUserBannerServer) Send(m *UserBannerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BannerService_ListBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBannersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ListBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ListBanners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ListBanners(ctx, req.(*ListBannersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_GetBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetBanner(ctx, req.(*GetBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_CreateBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).CreateBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_CreateBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).CreateBanner(ctx, req.(*CreateBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_PatchBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).PatchBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_PatchBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).PatchBanner(ctx, req.(*PatchBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_DeleteBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).DeleteBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_DeleteBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).DeleteBanner(ctx, req.(*DeleteBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_SubmitBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).SubmitBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_SubmitBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).SubmitBanner(ctx, req.(*BannerTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ApproveBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ApproveBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ApproveBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ApproveBanner(ctx, req.(*BannerTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_RejectBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).RejectBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_RejectBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).RejectBanner(ctx, req.(*BannerTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_PublishBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).PublishBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_PublishBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).PublishBanner(ctx, req.(*BannerTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ArchiveBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ArchiveBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ArchiveBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ArchiveBanner(ctx, req.(*BannerTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_GetUserBannerBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserBannerBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetUserBannerBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetUserBannerBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetUserBannerBatch(ctx, req.(*UserBannerBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ListBannerConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ListBannerConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ListBannerConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ListBannerConflicts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_BulkBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkBannersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).BulkBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_BulkBanners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).BulkBanners(ctx, req.(*BulkBannersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_BulkDeleteBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteBannersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).BulkDeleteBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_BulkDeleteBanners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).BulkDeleteBanners(ctx, req.(*BulkDeleteBannersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ExportBanners_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBannersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BannerServiceServer).ExportBanners(m, &bannerServiceExportBannersServer{stream})
+}
+
+type BannerService_ExportBannersServer interface {
+	Send(*Banner) error
+	grpc.ServerStream
+}
+
+type bannerServiceExportBannersServer struct {
+	grpc.ServerStream
+}
+
+func (x *bannerServiceExportBannersServer) Send(m *Banner) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BannerService_ImportBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBannersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ImportBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ImportBanners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ImportBanners(ctx, req.(*ImportBannersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_RestoreBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).RestoreBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_RestoreBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).RestoreBanner(ctx, req.(*RestoreBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ListFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ListFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ListFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ListFeatures(ctx, req.(*ListCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_GetFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetFeature(ctx, req.(*CatalogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_CreateFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).CreateFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_CreateFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).CreateFeature(ctx, req.(*CatalogEntryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_UpdateFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCatalogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).UpdateFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_UpdateFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).UpdateFeature(ctx, req.(*UpdateCatalogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_DeleteFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).DeleteFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_DeleteFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).DeleteFeature(ctx, req.(*CatalogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_GetFeatureSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetFeatureSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetFeatureSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetFeatureSchema(ctx, req.(*CatalogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_SetFeatureSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeatureSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).SetFeatureSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_SetFeatureSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).SetFeatureSchema(ctx, req.(*SetFeatureSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_DeleteFeatureSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).DeleteFeatureSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_DeleteFeatureSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).DeleteFeatureSchema(ctx, req.(*CatalogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ListTags(ctx, req.(*ListCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetTag(ctx, req.(*CatalogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).CreateTag(ctx, req.(*CatalogEntryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCatalogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).UpdateTag(ctx, req.(*UpdateCatalogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).DeleteTag(ctx, req.(*CatalogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetWebhook(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).CreateWebhook(ctx, req.(*WebhookInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).DeleteWebhook(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_RedeliverWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).RedeliverWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_RedeliverWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).RedeliverWebhookDelivery(ctx, req.(*RedeliverWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_ListAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).ListAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_ListAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).ListAudit(ctx, req.(*ListAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannerService_GetReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannerServiceServer).GetReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BannerService_GetReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannerServiceServer).GetReadiness(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BannerService_ServiceDesc is the grpc.ServiceDesc for BannerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BannerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "banner.v1.BannerService",
+	HandlerType: (*BannerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUserBanner",
+			Handler:    _BannerService_GetUserBanner_Handler,
+		},
+		{
+			MethodName: "ListBanners",
+			Handler:    _BannerService_ListBanners_Handler,
+		},
+		{
+			MethodName: "GetBanner",
+			Handler:    _BannerService_GetBanner_Handler,
+		},
+		{
+			MethodName: "CreateBanner",
+			Handler:    _BannerService_CreateBanner_Handler,
+		},
+		{
+			MethodName: "PatchBanner",
+			Handler:    _BannerService_PatchBanner_Handler,
+		},
+		{
+			MethodName: "DeleteBanner",
+			Handler:    _BannerService_DeleteBanner_Handler,
+		},
+		{
+			MethodName: "SubmitBanner",
+			Handler:    _BannerService_SubmitBanner_Handler,
+		},
+		{
+			MethodName: "ApproveBanner",
+			Handler:    _BannerService_ApproveBanner_Handler,
+		},
+		{
+			MethodName: "RejectBanner",
+			Handler:    _BannerService_RejectBanner_Handler,
+		},
+		{
+			MethodName: "PublishBanner",
+			Handler:    _BannerService_PublishBanner_Handler,
+		},
+		{
+			MethodName: "ArchiveBanner",
+			Handler:    _BannerService_ArchiveBanner_Handler,
+		},
+		{
+			MethodName: "GetUserBannerBatch",
+			Handler:    _BannerService_GetUserBannerBatch_Handler,
+		},
+		{
+			MethodName: "ListBannerConflicts",
+			Handler:    _BannerService_ListBannerConflicts_Handler,
+		},
+		{
+			MethodName: "BulkBanners",
+			Handler:    _BannerService_BulkBanners_Handler,
+		},
+		{
+			MethodName: "BulkDeleteBanners",
+			Handler:    _BannerService_BulkDeleteBanners_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _BannerService_GetJob_Handler,
+		},
+		{
+			MethodName: "ImportBanners",
+			Handler:    _BannerService_ImportBanners_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _BannerService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreBanner",
+			Handler:    _BannerService_RestoreBanner_Handler,
+		},
+		{
+			MethodName: "ListFeatures",
+			Handler:    _BannerService_ListFeatures_Handler,
+		},
+		{
+			MethodName: "GetFeature",
+			Handler:    _BannerService_GetFeature_Handler,
+		},
+		{
+			MethodName: "CreateFeature",
+			Handler:    _BannerService_CreateFeature_Handler,
+		},
+		{
+			MethodName: "UpdateFeature",
+			Handler:    _BannerService_UpdateFeature_Handler,
+		},
+		{
+			MethodName: "DeleteFeature",
+			Handler:    _BannerService_DeleteFeature_Handler,
+		},
+		{
+			MethodName: "GetFeatureSchema",
+			Handler:    _BannerService_GetFeatureSchema_Handler,
+		},
+		{
+			MethodName: "SetFeatureSchema",
+			Handler:    _BannerService_SetFeatureSchema_Handler,
+		},
+		{
+			MethodName: "DeleteFeatureSchema",
+			Handler:    _BannerService_DeleteFeatureSchema_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _BannerService_ListTags_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _BannerService_GetTag_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _BannerService_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _BannerService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _BannerService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _BannerService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _BannerService_GetWebhook_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _BannerService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _BannerService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _BannerService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _BannerService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhookDelivery",
+			Handler:    _BannerService_RedeliverWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "ListAudit",
+			Handler:    _BannerService_ListAudit_Handler,
+		},
+		{
+			MethodName: "GetReadiness",
+			Handler:    _BannerService_GetReadiness_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchUserBanner",
+			Handler:       _BannerService_WatchUserBanner_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportBanners",
+			Handler:       _BannerService_ExportBanners_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "banner.proto",
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/labstack/echo/v4"
+)
+
+// Bulk delete moves banners to trash in batches of this size, so no statement holds row locks for long
+const (
+	bulkDeleteBatchSize  = 500
+	bulkDeleteBatchPause = 50 * time.Millisecond
+)
+
+// Parameters of bulk delete job. Actor, request id and source IP are the audit source of the request that created the job,
+// deleted banners are logged to audit on its behalf
+type bulkDeleteParams struct {
+	FeatureID *int   `json:"feature_id,omitempty"`
+	TagID     *int   `json:"tag_id,omitempty"`
+	Actor     string `json:"actor,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	SourceIP  string `json:"source_ip,omitempty"`
+}
+
+func (s *Server) DeleteBanner(ctx echo.Context, params DeleteBannerParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	id, writeErr, err := s.enqueueBulkDelete(params.FeatureId, params.TagId, s.auditSource(ctx, *params.Token))
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	ctx.Response().Header().Set("Location", fmt.Sprintf("/jobs/%d", id))
+	return ctx.JSON(http.StatusAccepted, JobAccepted{JobId: id})
+}
+
+// Checks filter and queues bulk delete job. Returns id of the job. Used by REST and gRPC APIs
+func (s *Server) enqueueBulkDelete(featureID *int, tagID *int, audit auditSource) (int, *bannerWriteError, error) {
+	if errs := validateBulkDeleteFilter(featureID, tagID); len(errs) > 0 {
+		return 0, invalidBannerError(errs), nil
+	}
+
+	jobParams := bulkDeleteParams{
+		FeatureID: featureID,
+		TagID:     tagID,
+		Actor:     audit.actor,
+		RequestID: audit.requestID,
+		SourceIP:  audit.sourceIP,
+	}
+	id, err := s.enqueueJob(jobKindBulkDelete, jobParams)
+	return id, nil, err
+}
+
+// Checks filter of bulk delete. Empty filter would delete all banners
+func validateBulkDeleteFilter(featureID *int, tagID *int) []FieldError {
+	if featureID == nil && tagID == nil {
+		return []FieldError{{Field: "feature_id", Message: "feature_id or tag_id is required"}}
+	}
+
+	var errs []FieldError
+	if featureID != nil && *featureID <= 0 {
+		errs = append(errs, FieldError{Field: "feature_id", Message: "must be a positive integer"})
+	}
+	if tagID != nil && *tagID <= 0 {
+		errs = append(errs, FieldError{Field: "tag_id", Message: "must be a positive integer"})
+	}
+	return errs
+}
+
+// Wrapper function for building filter of bulk delete queries.
+// Banners match by edited or published values, users may still get banner by its published ones
+func bulkDeleteFilterBuilder(params bulkDeleteParams) (string, []interface{}) {
+	query := " WHERE deleted_at IS NULL"
+	args := []interface{}{}
+	count := 1
+
+	if params.FeatureID != nil {
+		query += fmt.Sprintf(" AND (feature_id = $%d OR published_feature_id = $%d)", count, count)
+		args = append(args, *params.FeatureID)
+		count++
+	}
+
+	if params.TagID != nil {
+		query += fmt.Sprintf(" AND (tag_ids @> ARRAY[$%d::int] OR published_tag_ids @> ARRAY[$%d::int])", count, count)
+		args = append(args, *params.TagID)
+		count++
+	}
+	return query, args
+}
+
+// Moves matching banners to trash batch by batch and drops cached user banners of their features
+func (s *Server) runBulkDelete(ctx context.Context, jobID int, data []byte) error {
+	var params bulkDeleteParams
+
+	if err := json.Unmarshal(data, &params); err != nil {
+		return err
+	}
+
+	filter, args := bulkDeleteFilterBuilder(params)
+	var total int
+	err := s.db.QueryRowContext(ctx, "SELECT count(*) FROM banners"+filter, args...).Scan(&total)
+
+	if err != nil {
+		return err
+	}
+	if err := setJobTotal(s.db, jobID, total); err != nil {
+		return err
+	}
+
+	audit := auditSource{actor: params.Actor, requestID: params.RequestID, sourceIP: params.SourceIP}
+	query := fmt.Sprintf("UPDATE banners SET deleted_at = now() WHERE id IN (SELECT id FROM banners%s ORDER BY id LIMIT %d) RETURNING id, deleted_at, published_feature_id", filter, bulkDeleteBatchSize)
+	for {
+		featureIDs, deleted, err := s.deleteBannerBatch(ctx, query, args, audit)
+
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return nil
+		}
+		if err := addJobProgress(s.db, jobID, deleted); err != nil {
+			return err
+		}
+		if err := invalidateFeatureCache(ctx, s.cache, featureIDs); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(bulkDeleteBatchPause):
+		}
+	}
+}
+
+// Runs one batch of bulk delete in a transaction with audit entries of deleted banners.
+// Returns features of published revisions of deleted banners and number of deleted banners
+func (s *Server) deleteBannerBatch(ctx context.Context, query string, args []interface{}, audit auditSource) ([]int, int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	type deletedBanner struct {
+		id        int
+		deletedAt time.Time
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var banners []deletedBanner
+	var featureIDs []int
+	seen := map[int]bool{}
+	for rows.Next() {
+		var banner deletedBanner
+		var featureID sql.NullInt64
+		if err := rows.Scan(&banner.id, &banner.deletedAt, &featureID); err != nil {
+			rows.Close()
+			return nil, 0, err
+		}
+		banners = append(banners, banner)
+
+		// Users never got unpublished banners, so their cache has nothing to drop
+		if featureID.Valid && !seen[int(featureID.Int64)] {
+			seen[int(featureID.Int64)] = true
+			featureIDs = append(featureIDs, int(featureID.Int64))
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Audit entries are written after all rows are read, a transaction runs one statement at a time
+	for _, banner := range banners {
+		err := recordBannerChange(tx, audit, auditDelete, banner.id, auditFieldDiff("deleted_at", nil, banner.deletedAt))
+
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return featureIDs, len(banners), tx.Commit()
+}
+
+// Removes cached user banners of given features and announces the change to user banner streams.
+// Cache keys start with feature id, see userBannerCacheKey
+func invalidateFeatureCache(ctx context.Context, cache *redis.Client, featureIDs []int) error {
+	for _, featureID := range featureIDs {
+		var keys []string
+		iter := cache.Scan(ctx, 0, fmt.Sprintf("%d:*", featureID), 100).Iterator()
+
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if err := cache.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+	}
+	return publishFeatureUpdates(ctx, cache, featureIDs)
+}
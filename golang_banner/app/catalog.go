@@ -0,0 +1,459 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+const catalogColumns = "id, name, description, owner, archived, created_at, updated_at"
+
+// Features and tags are stored and served the same way, catalog holds what differs between them
+type catalog struct {
+	table    string
+	kind     string
+	notFound string
+	inUse    string
+	// Converts stored entry to response body
+	view func(entry Feature) interface{}
+}
+
+var featureCatalog = catalog{
+	table:    "features",
+	kind:     "feature",
+	notFound: "Фича не найдена",
+	inUse:    "На фичу ссылаются баннеры",
+	view:     func(entry Feature) interface{} { return entry },
+}
+
+var tagCatalog = catalog{
+	table:    "tags",
+	kind:     "tag",
+	notFound: "Тег не найден",
+	inUse:    "На тег ссылаются баннеры",
+	view:     func(entry Feature) interface{} { return Tag(entry) },
+}
+
+func (s *Server) GetFeatures(ctx echo.Context, params GetFeaturesParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.listCatalog(ctx, featureCatalog, params.Archived, params.Limit, params.Offset)
+}
+
+func (s *Server) PostFeatures(ctx echo.Context, params PostFeaturesParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.createCatalogEntry(ctx, featureCatalog)
+}
+
+func (s *Server) GetFeaturesId(ctx echo.Context, id int, params GetFeaturesIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.getCatalogEntry(ctx, featureCatalog, id)
+}
+
+func (s *Server) PatchFeaturesId(ctx echo.Context, id int, params PatchFeaturesIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.updateCatalogEntry(ctx, featureCatalog, id)
+}
+
+func (s *Server) DeleteFeaturesId(ctx echo.Context, id int, params DeleteFeaturesIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.deleteCatalogEntry(ctx, featureCatalog, id)
+}
+
+func (s *Server) GetTags(ctx echo.Context, params GetTagsParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.listCatalog(ctx, tagCatalog, params.Archived, params.Limit, params.Offset)
+}
+
+func (s *Server) PostTags(ctx echo.Context, params PostTagsParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.createCatalogEntry(ctx, tagCatalog)
+}
+
+func (s *Server) GetTagsId(ctx echo.Context, id int, params GetTagsIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.getCatalogEntry(ctx, tagCatalog, id)
+}
+
+func (s *Server) PatchTagsId(ctx echo.Context, id int, params PatchTagsIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.updateCatalogEntry(ctx, tagCatalog, id)
+}
+
+func (s *Server) DeleteTagsId(ctx echo.Context, id int, params DeleteTagsIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.deleteCatalogEntry(ctx, tagCatalog, id)
+}
+
+func (s *Server) listCatalog(ctx echo.Context, c catalog, archived *bool, limit *int, offset *int) error {
+	entries, writeErr, err := s.catalogEntries(c, archived, limit, offset)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+
+	views := []interface{}{}
+	for _, entry := range entries {
+		views = append(views, c.view(entry))
+	}
+	return ctx.JSON(http.StatusOK, views)
+}
+
+func (s *Server) createCatalogEntry(ctx echo.Context, c catalog) error {
+	data, err := readRequestBody(ctx.Request().Body)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	input, fieldErrs := decodeCatalogInput(data, true)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+
+	entry, writeErr, err := s.insertCatalogEntry(c, input)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusCreated, c.view(entry))
+}
+
+func (s *Server) getCatalogEntry(ctx echo.Context, c catalog, id int) error {
+	entry, writeErr, err := s.findCatalogEntry(c, id)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusOK, c.view(entry))
+}
+
+func (s *Server) updateCatalogEntry(ctx echo.Context, c catalog, id int) error {
+	data, err := readRequestBody(ctx.Request().Body)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	input, fieldErrs := decodeCatalogInput(data, false)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+
+	entry, writeErr, err := s.patchCatalogEntry(c, id, input)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusOK, c.view(entry))
+}
+
+func (s *Server) deleteCatalogEntry(ctx echo.Context, c catalog, id int) error {
+	writeErr, err := s.removeCatalogEntry(c, id)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Reads page of features or tags. Used by REST and gRPC APIs
+func (s *Server) catalogEntries(c catalog, archived *bool, limit *int, offset *int) ([]Feature, *bannerWriteError, error) {
+	if fieldErrs := validateLimitOffset(limit, offset); len(fieldErrs) > 0 {
+		return nil, invalidBannerError(fieldErrs), nil
+	}
+
+	query, args := listCatalogQueryBuilder(c.table, archived, limit, offset)
+	rows, err := s.db.Query(query, args...)
+
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	var entries []Feature
+	for rows.Next() {
+		entry, err := scanCatalogEntry(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil, rows.Err()
+}
+
+// Stores new feature or tag from validated input. Used by REST and gRPC APIs
+func (s *Server) insertCatalogEntry(c catalog, input catalogInput) (Feature, *bannerWriteError, error) {
+	description, owner, archived := "", "", false
+	if input.description != nil {
+		description = *input.description
+	}
+	if input.owner != nil {
+		owner = *input.owner
+	}
+	if input.archived != nil {
+		archived = *input.archived
+	}
+
+	query := "INSERT INTO " + c.table + " (name, description, owner, archived) VALUES ($1, $2, $3, $4) RETURNING " + catalogColumns
+	entry, err := scanCatalogEntry(s.db.QueryRow(query, *input.name, description, owner, archived))
+
+	if err != nil && isUniqueViolation(err) {
+		return entry, c.nameTaken(*input.name), nil
+	}
+	return entry, nil, err
+}
+
+// Reads feature or tag by id. Used by REST and gRPC APIs
+func (s *Server) findCatalogEntry(c catalog, id int) (Feature, *bannerWriteError, error) {
+	entry, err := scanCatalogEntry(s.db.QueryRow("SELECT "+catalogColumns+" FROM "+c.table+" WHERE id = $1", id))
+
+	if err == sql.ErrNoRows {
+		return entry, &bannerWriteError{status: http.StatusNotFound, message: c.notFound}, nil
+	}
+	return entry, nil, err
+}
+
+// Updates fields of feature or tag set in validated input. Used by REST and gRPC APIs
+func (s *Server) patchCatalogEntry(c catalog, id int, input catalogInput) (Feature, *bannerWriteError, error) {
+	query, args := updateCatalogQueryBuilder(c.table, id, input)
+	entry, err := scanCatalogEntry(s.db.QueryRow(query, args...))
+
+	if err == sql.ErrNoRows {
+		return entry, &bannerWriteError{status: http.StatusNotFound, message: c.notFound}, nil
+	} else if err != nil && isUniqueViolation(err) {
+		return entry, c.nameTaken(*input.name), nil
+	}
+	return entry, nil, err
+}
+
+// Deletes feature or tag no banner refers to. Used by REST and gRPC APIs
+func (s *Server) removeCatalogEntry(c catalog, id int) (*bannerWriteError, error) {
+	result, err := s.db.Exec("DELETE FROM "+c.table+" WHERE id = $1", id)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return &bannerWriteError{status: http.StatusConflict, message: c.inUse}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	deleted, err := result.RowsAffected()
+
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return &bannerWriteError{status: http.StatusNotFound, message: c.notFound}, nil
+	}
+	return nil, nil
+}
+
+// Builds 409 rejection of taken name
+func (c catalog) nameTaken(name string) *bannerWriteError {
+	return &bannerWriteError{
+		status:  http.StatusConflict,
+		message: c.kind + " conflicts with existing " + c.kind,
+		fields:  []FieldError{{Field: "name", Message: fmt.Sprintf("%s %q already exists", c.kind, name)}},
+	}
+}
+
+// Scans feature or tag selected with catalogColumns
+func scanCatalogEntry(row interface{ Scan(...interface{}) error }) (Feature, error) {
+	var entry Feature
+	err := row.Scan(&entry.Id, &entry.Name, &entry.Description, &entry.Owner, &entry.Archived, &entry.CreatedAt, &entry.UpdatedAt)
+	return entry, err
+}
+
+// Checks if err is a violation of unique constraint
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// Wrapper function for building params for listCatalog query
+func listCatalogQueryBuilder(table string, archived *bool, limit *int, offset *int) (string, []interface{}) {
+	query := "SELECT " + catalogColumns + " FROM " + table
+	args := []interface{}{}
+	count := 1
+
+	if archived != nil {
+		query += fmt.Sprintf(" WHERE archived = $%d", count)
+		args = append(args, *archived)
+		count++
+	}
+	query += " ORDER BY id"
+
+	if limit != nil {
+		query += fmt.Sprintf(" LIMIT $%d", count)
+		args = append(args, *limit)
+		count++
+	}
+
+	if offset != nil {
+		query += fmt.Sprintf(" OFFSET $%d", count)
+		args = append(args, *offset)
+		count++
+	}
+	return query, args
+}
+
+// Wrapper function for building params for updateCatalogEntry query. Update without fields only selects the entry
+func updateCatalogQueryBuilder(table string, id int, input catalogInput) (string, []interface{}) {
+	query := "UPDATE " + table + " SET"
+	args := []interface{}{}
+	count := 1
+
+	for _, field := range []struct {
+		column string
+		value  interface{}
+		set    bool
+	}{
+		{"name", input.name, input.name != nil},
+		{"description", input.description, input.description != nil},
+		{"owner", input.owner, input.owner != nil},
+		{"archived", input.archived, input.archived != nil},
+	} {
+		if !field.set {
+			continue
+		}
+		if count > 1 {
+			query += ","
+		}
+		query += fmt.Sprintf(" %s = $%d", field.column, count)
+		args = append(args, field.value)
+		count++
+	}
+
+	if count == 1 {
+		return "SELECT " + catalogColumns + " FROM " + table + " WHERE id = $1", []interface{}{id}
+	}
+	query += fmt.Sprintf(" WHERE id = $%d RETURNING %s", count, catalogColumns)
+	args = append(args, id)
+	return query, args
+}
+
+// Fills feature and tags of banners with their names. Tags keep the order of tag_ids
+func embedBannerNames(db *sql.DB, banners []Banner) error {
+	var featureIDs, tagIDs []int
+	for _, banner := range banners {
+		featureIDs = append(featureIDs, banner.FeatureID)
+		for _, tagID := range banner.TagIDs {
+			tagIDs = append(tagIDs, int(tagID))
+		}
+	}
+	if len(banners) == 0 {
+		return nil
+	}
+
+	featureNames, err := catalogNames(db, "features", uniqueIDs(featureIDs))
+
+	if err != nil {
+		return err
+	}
+
+	tagNames, err := catalogNames(db, "tags", uniqueIDs(tagIDs))
+
+	if err != nil {
+		return err
+	}
+
+	for i := range banners {
+		banner := &banners[i]
+		banner.Feature = &EntityRef{Id: banner.FeatureID, Name: featureNames[banner.FeatureID]}
+		banner.Tags = make([]EntityRef, len(banner.TagIDs))
+		for j, tagID := range banner.TagIDs {
+			banner.Tags[j] = EntityRef{Id: int(tagID), Name: tagNames[int(tagID)]}
+		}
+	}
+	return nil
+}
+
+// Selects names of features or tags by ids
+func catalogNames(db *sql.DB, table string, ids []int) (map[int]string, error) {
+	rows, err := db.Query("SELECT id, name FROM "+table+" WHERE id = ANY($1::int[])", pq.Array(ids))
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	names := make(map[int]string, len(ids))
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		names[id] = name
+	}
+	return names, rows.Err()
+}
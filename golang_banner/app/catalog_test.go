@@ -0,0 +1,260 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeCatalogInput(t *testing.T) {
+	input, errs := decodeCatalogInput([]byte(`{"name": "  checkout  ", "owner": "payments", "archived": false}`), true)
+	assert.Empty(t, errs)
+	assert.Equal(t, "checkout", *input.name)
+	assert.Nil(t, input.description)
+
+	_, errs = decodeCatalogInput([]byte(`{"description": 1, "color": "red"}`), true)
+	assert.Equal(t, []FieldError{
+		{Field: "name", Message: "is required"},
+		{Field: "description", Message: "must be a string"},
+		{Field: "color", Message: "unknown field"},
+	}, errs)
+
+	input, errs = decodeCatalogInput([]byte(`{"archived": true}`), false)
+	assert.Empty(t, errs)
+	assert.True(t, *input.archived)
+}
+
+func TestUpdateCatalogQueryBuilder(t *testing.T) {
+	archived := true
+	owner := "growth"
+	query, args := updateCatalogQueryBuilder("tags", 4, catalogInput{owner: &owner, archived: &archived})
+	assert.Equal(t, "UPDATE tags SET owner = $1, archived = $2 WHERE id = $3 RETURNING "+catalogColumns, query)
+	assert.Equal(t, []interface{}{&owner, &archived, 4}, args)
+
+	query, args = updateCatalogQueryBuilder("tags", 4, catalogInput{})
+	assert.Equal(t, "SELECT "+catalogColumns+" FROM tags WHERE id = $1", query)
+	assert.Equal(t, []interface{}{4}, args)
+}
+
+func TestPostFeatures(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	db_mock.ExpectQuery("INSERT INTO features (name, description, owner, archived) VALUES ($1, $2, $3, $4) RETURNING "+catalogColumns).
+		WithArgs("checkout", "", "payments", false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "owner", "archived", "created_at", "updated_at"}).
+			AddRow(3, "checkout", "", "payments", false, now, now))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/features", strings.NewReader(`{"name": "checkout", "owner": "payments"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostFeatures(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var feature Feature
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &feature))
+		assert.Equal(t, 3, feature.Id)
+		assert.Equal(t, "payments", feature.Owner)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostTagsNameTaken(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectQuery("INSERT INTO tags (name, description, owner, archived) VALUES ($1, $2, $3, $4) RETURNING "+catalogColumns).
+		WithArgs("beta", "", "", false).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/tags", strings.NewReader(`{"name": "beta"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostTags(c)) {
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteFeaturesIdInUse(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectExec("DELETE FROM features WHERE id = $1").
+		WithArgs(2).
+		WillReturnError(&pq.Error{Code: "23503"})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/features/2", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	if assert.NoError(t, wrapper.DeleteFeaturesId(c)) {
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteFeaturesIdUsedByPublishedRevision(t *testing.T) {
+	db := openMigrateTestDB(t)
+	migrations, err := loadMigrations(migrationFiles)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrateUp(db, migrations); err != nil {
+		t.Fatal(err)
+	}
+
+	// Banner moved to feature 2 in a draft, users still get its published revision of feature 1
+	queries := []string{
+		"INSERT INTO features (id, name) VALUES (1, 'published'), (2, 'edited')",
+		`INSERT INTO banners (tag_ids, feature_id, content, is_active, state, published_tag_ids, published_feature_id, published_content, published_is_active, published_priority, published_revision, published_at)
+		VALUES ('{}', 2, '{}', true, 'draft', '{}', 1, '{}', true, 0, 1, now())`,
+	}
+	for _, query := range queries {
+		if _, err := db.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	server := &Server{
+		tokens: map[string]string{"IGOTTHEPOWER!": "admin"},
+		db:     db,
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/features/1", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	if assert.NoError(t, wrapper.DeleteFeaturesId(c)) {
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	}
+
+	var count int
+	if assert.NoError(t, db.QueryRow("SELECT count(*) FROM features WHERE id = 1").Scan(&count)) {
+		assert.Equal(t, 1, count)
+	}
+}
+
+func TestPostBannerArchivedRefs(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT archived FROM features WHERE id = $1 FOR SHARE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"archived"}).AddRow(true))
+	db_mock.ExpectQuery("SELECT id, archived FROM tags WHERE id = ANY($1::int[]) FOR SHARE").
+		WithArgs(pq.Array([]int{1, 5})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "archived"}).AddRow(1, false))
+	db_mock.ExpectRollback()
+
+	e := echo.New()
+	body := `{"tag_ids": [1, 5], "feature_id": 2, "content": {}, "is_active": true}`
+	req := httptest.NewRequest(http.MethodPost, "/banner", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBanner(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var response ValidationError
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, []FieldError{
+			{Field: "feature_id", Message: "feature 2 is archived"},
+			{Field: "tag_ids[1]", Message: "tag 5 does not exist"},
+		}, response.Fields)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetBannerEmbedNames(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	db_mock.ExpectQuery("SELECT count(*) FROM banners WHERE deleted_at IS NULL").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	db_mock.ExpectQuery("SELECT " + bannerColumns + " FROM banners WHERE deleted_at IS NULL ORDER BY id ASC").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag_ids", "feature_id", "content", "is_active", "created_at", "updated_at", "revision", "priority", "deleted_at", "state", "published_revision", "published_at", "review_comment"}).
+			AddRow(1, "{3,1}", 2, []byte(`{}`), true, now, now, 1, 0, nil, "published", 1, now, nil))
+	db_mock.ExpectQuery("SELECT id, name FROM features WHERE id = ANY($1::int[])").
+		WithArgs(pq.Array([]int{2})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "checkout"))
+	db_mock.ExpectQuery("SELECT id, name FROM tags WHERE id = ANY($1::int[])").
+		WithArgs(pq.Array([]int{3, 1})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "new users").AddRow(3, "beta"))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner?embed=names", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBanner(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var banners []Banner
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &banners))
+		if assert.Len(t, banners, 1) {
+			assert.Equal(t, &EntityRef{Id: 2, Name: "checkout"}, banners[0].Feature)
+			assert.Equal(t, []EntityRef{{Id: 3, Name: "beta"}, {Id: 1, Name: "new users"}}, banners[0].Tags)
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Expired entries are purged when cache grows to this size
+const userBannerCachePurgeSize = 1024
+
+type cachedUserBanner struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+// Local TTL cache of user banner responses
+type userBannerCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedUserBanner
+}
+
+func newUserBannerCache() *userBannerCache {
+	return &userBannerCache{entries: make(map[string]cachedUserBanner)}
+}
+
+// Key of feature and tags. Tags are sorted, so their order does not matter
+func userBannerKey(featureID int, tagIDs []int) string {
+	sorted := append([]int(nil), tagIDs...)
+	sort.Ints(sorted)
+
+	var key strings.Builder
+	fmt.Fprintf(&key, "%d:", featureID)
+	for i, id := range sorted {
+		if i > 0 && id == sorted[i-1] {
+			continue
+		}
+		fmt.Fprintf(&key, "%d,", id)
+	}
+	return key.String()
+}
+
+func (c *userBannerCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (c *userBannerCache) set(key string, body []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= userBannerCachePurgeSize {
+		for key, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+	}
+	c.entries[key] = cachedUserBanner{body: body, expiresAt: now.Add(ttl)}
+}
@@ -0,0 +1,553 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors of responses with these statuses match them in errors.Is
+var (
+	ErrUnauthorized = errors.New("banner api: unauthorized")
+	ErrForbidden    = errors.New("banner api: forbidden")
+	ErrNotFound     = errors.New("banner api: not found")
+)
+
+// Response with unexpected status
+type APIError struct {
+	StatusCode int
+	Message    string
+	// Field errors of ValidationError responses
+	Fields []FieldError
+	Body   []byte
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("banner api: status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("banner api: status %d: %s", e.StatusCode, e.Message)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// Builds error of response. Message is taken from ValidationError, JSON string or text body
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: res.StatusCode, Body: body}
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+
+	if mediaType != "application/json" {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return apiErr
+	}
+
+	var validation ValidationError
+	if err := json.Unmarshal(body, &validation); err == nil && validation.Error != "" {
+		apiErr.Message = validation.Error
+		apiErr.Fields = validation.Fields
+	} else if err := json.Unmarshal(body, &apiErr.Message); err != nil {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	return apiErr
+}
+
+const (
+	defaultMaxRetries     = 3
+	defaultRetryBaseDelay = 100 * time.Millisecond
+	defaultTimeout        = 10 * time.Second
+	// Same as the server cache, so the client does not keep banners longer than they are kept there
+	defaultUserBannerTTL = 5 * time.Minute
+)
+
+// Client of the banner API on top of generated ClientWithResponses. Sends token with every request, retries
+// server errors, limits every call with timeout and caches user banners
+type BannerClient struct {
+	api           *ClientWithResponses
+	timeout       time.Duration
+	userBanners   *userBannerCache
+	userBannerTTL time.Duration
+}
+
+type options struct {
+	doer           HttpRequestDoer
+	maxRetries     int
+	retryBaseDelay time.Duration
+	timeout        time.Duration
+	userBannerTTL  time.Duration
+}
+
+type Option func(*options)
+
+// Sends requests with doer instead of http.DefaultClient
+func WithHTTPDoer(doer HttpRequestDoer) Option {
+	return func(o *options) {
+		o.doer = doer
+	}
+}
+
+// Retries failed requests up to maxRetries times, waiting a random time up to baseDelay*2^attempt in between.
+// 0 disables retries
+func WithRetries(maxRetries int, baseDelay time.Duration) Option {
+	return func(o *options) {
+		o.maxRetries = maxRetries
+		o.retryBaseDelay = baseDelay
+	}
+}
+
+// Limits calls whose context has no deadline, retries included. 0 disables the limit
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// Sets how long UserBanner keeps banners. 0 disables the cache
+func WithUserBannerTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.userBannerTTL = ttl
+	}
+}
+
+// Creates client of server, for example http://127.0.0.1:8080, that authorizes with token
+func New(server string, token string, opts ...Option) (*BannerClient, error) {
+	o := options{
+		doer:           http.DefaultClient,
+		maxRetries:     defaultMaxRetries,
+		retryBaseDelay: defaultRetryBaseDelay,
+		timeout:        defaultTimeout,
+		userBannerTTL:  defaultUserBannerTTL,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	doer := &retryDoer{doer: o.doer, maxRetries: o.maxRetries, baseDelay: o.retryBaseDelay}
+	api, err := NewClientWithResponses(server, WithHTTPClient(doer), WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("token", token)
+		return nil
+	}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &BannerClient{
+		api:           api,
+		timeout:       o.timeout,
+		userBanners:   newUserBannerCache(),
+		userBannerTTL: o.userBannerTTL,
+	}, nil
+}
+
+// Generated client with the same token and retries, for operations without typed methods
+func (c *BannerClient) API() *ClientWithResponses {
+	return c.api
+}
+
+func (c *BannerClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.timeout == 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// Returns APIError and closes response if it does not have expected status. Useful for calls made with API(),
+// whose generated parsers fail on error bodies that differ from api.yaml
+func CheckStatus(res *http.Response, expected int) error {
+	if res.StatusCode == expected {
+		return nil
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+
+	if err != nil {
+		return err
+	}
+	return newAPIError(res, body)
+}
+
+// Error for successful response that generated client could not decode
+func unexpectedBody(res *http.Response, body []byte) error {
+	return &APIError{StatusCode: res.StatusCode, Message: "unexpected response body", Body: body}
+}
+
+// Banner content user with tags gets for feature. Banners are cached for the TTL, so changes reach the
+// caller with the same delay as they reach users
+func (c *BannerClient) UserBanner(ctx context.Context, featureID int, tagIDs ...int) (map[string]interface{}, error) {
+	key := userBannerKey(featureID, tagIDs)
+	body, ok := c.userBanners.get(key)
+
+	if !ok {
+		var err error
+		body, err = c.userBanner(ctx, featureID, tagIDs, false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if c.userBannerTTL > 0 {
+			c.userBanners.set(key, body, c.userBannerTTL)
+		}
+	}
+
+	// Decoding every time, so callers can't change cached banner
+	var content map[string]interface{}
+	if err := json.Unmarshal(body, &content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// Like UserBanner, but reads the banner past both caches. Admin gets the latest revision, even unpublished
+func (c *BannerClient) FreshUserBanner(ctx context.Context, featureID int, tagIDs ...int) (map[string]interface{}, error) {
+	body, err := c.userBanner(ctx, featureID, tagIDs, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var content map[string]interface{}
+	if err := json.Unmarshal(body, &content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// Reads user banner and returns its JSON
+func (c *BannerClient) userBanner(ctx context.Context, featureID int, tagIDs []int, useLastRevision bool) ([]byte, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	params := &GetUserBannerParams{FeatureId: featureID, TagId: tagIDs}
+	if useLastRevision {
+		params.UseLastRevision = &useLastRevision
+	}
+	res, err := c.api.GetUserBanner(ctx, params)
+
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckStatus(res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	parsed, err := ParseGetUserBannerResponse(res)
+
+	if err != nil {
+		return nil, err
+	}
+	if parsed.JSON200 == nil {
+		return nil, unexpectedBody(res, parsed.Body)
+	}
+	return parsed.Body, nil
+}
+
+// Banners of several features in one request. Every item has its own status
+func (c *BannerClient) UserBanners(ctx context.Context, items []UserBannerBatchItem) ([]UserBannerBatchResult, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.PostUserBannerBatch(ctx, &PostUserBannerBatchParams{}, UserBannerBatchRequest{Items: items})
+
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckStatus(res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	parsed, err := ParsePostUserBannerBatchResponse(res)
+
+	if err != nil {
+		return nil, err
+	}
+	if parsed.JSON200 == nil {
+		return nil, unexpectedBody(res, parsed.Body)
+	}
+	return parsed.JSON200.Items, nil
+}
+
+// Page of banners of GetBanner. NextCursor and TotalCount come from the X-Next-Cursor and X-Total-Count headers
+type BannerPage struct {
+	Banners []map[string]interface{}
+	// Cursor of the next page, empty on the last one
+	NextCursor string
+	TotalCount int
+}
+
+// Lists banners matching params
+func (c *BannerClient) Banners(ctx context.Context, params *GetBannerParams) (*BannerPage, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	if params == nil {
+		params = &GetBannerParams{}
+	}
+	res, err := c.api.GetBanner(ctx, params)
+
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckStatus(res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	page := &BannerPage{NextCursor: res.Header.Get("X-Next-Cursor")}
+	if err := json.Unmarshal(body, &page.Banners); err != nil {
+		return nil, unexpectedBody(res, body)
+	}
+	if total := res.Header.Get("X-Total-Count"); total != "" {
+		page.TotalCount, err = strconv.Atoi(total)
+
+		if err != nil {
+			return nil, unexpectedBody(res, body)
+		}
+	}
+	return page, nil
+}
+
+// Banner with its ETag, which PatchBanner and DeleteBanner take as If-Match
+func (c *BannerClient) Banner(ctx context.Context, id int) (map[string]interface{}, string, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.GetBannerId(ctx, id, &GetBannerIdParams{})
+
+	if err != nil {
+		return nil, "", err
+	}
+	if err := CheckStatus(res, http.StatusOK); err != nil {
+		return nil, "", err
+	}
+
+	parsed, err := ParseGetBannerIdResponse(res)
+
+	if err != nil {
+		return nil, "", err
+	}
+	if parsed.JSON200 == nil {
+		return nil, "", unexpectedBody(res, parsed.Body)
+	}
+	return *parsed.JSON200, res.Header.Get("ETag"), nil
+}
+
+// Creates banner and returns its id
+func (c *BannerClient) CreateBanner(ctx context.Context, banner BannerInput) (int, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.PostBanner(ctx, &PostBannerParams{}, banner)
+
+	if err != nil {
+		return 0, err
+	}
+	if err := CheckStatus(res, http.StatusCreated); err != nil {
+		return 0, err
+	}
+
+	parsed, err := ParsePostBannerResponse(res)
+
+	if err != nil {
+		return 0, err
+	}
+	if parsed.JSON201 == nil || parsed.JSON201.BannerId == nil {
+		return 0, unexpectedBody(res, parsed.Body)
+	}
+	return *parsed.JSON201.BannerId, nil
+}
+
+// Applies merge patch to banner and returns its new ETag. Empty ifMatch skips the revision check
+func (c *BannerClient) PatchBanner(ctx context.Context, id int, patch BannerPatch, ifMatch string) (string, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	params := &PatchBannerIdParams{}
+	if ifMatch != "" {
+		params.IfMatch = &ifMatch
+	}
+	res, err := c.api.PatchBannerIdWithApplicationMergePatchPlusJSONBody(ctx, id, params, patch)
+
+	if err != nil {
+		return "", err
+	}
+	if err := CheckStatus(res, http.StatusOK); err != nil {
+		return "", err
+	}
+
+	res.Body.Close()
+	return res.Header.Get("ETag"), nil
+}
+
+// Moves banner to trash. Empty ifMatch skips the revision check
+func (c *BannerClient) DeleteBanner(ctx context.Context, id int, ifMatch string) error {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	params := &DeleteBannerIdParams{}
+	if ifMatch != "" {
+		params.IfMatch = &ifMatch
+	}
+	res, err := c.api.DeleteBannerId(ctx, id, params)
+
+	if err != nil {
+		return err
+	}
+	if err := CheckStatus(res, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	res.Body.Close()
+	return nil
+}
+
+func (c *BannerClient) Features(ctx context.Context, params *GetFeaturesParams) ([]Feature, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	if params == nil {
+		params = &GetFeaturesParams{}
+	}
+	res, err := c.api.GetFeatures(ctx, params)
+
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckStatus(res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	parsed, err := ParseGetFeaturesResponse(res)
+
+	if err != nil {
+		return nil, err
+	}
+	if parsed.JSON200 == nil {
+		return nil, unexpectedBody(res, parsed.Body)
+	}
+	return *parsed.JSON200, nil
+}
+
+func (c *BannerClient) CreateFeature(ctx context.Context, input FeatureInput) (*Feature, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.PostFeatures(ctx, &PostFeaturesParams{}, input)
+
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckStatus(res, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	parsed, err := ParsePostFeaturesResponse(res)
+
+	if err != nil {
+		return nil, err
+	}
+	if parsed.JSON201 == nil {
+		return nil, unexpectedBody(res, parsed.Body)
+	}
+	return parsed.JSON201, nil
+}
+
+func (c *BannerClient) Tags(ctx context.Context, params *GetTagsParams) ([]Tag, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	if params == nil {
+		params = &GetTagsParams{}
+	}
+	res, err := c.api.GetTags(ctx, params)
+
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckStatus(res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	parsed, err := ParseGetTagsResponse(res)
+
+	if err != nil {
+		return nil, err
+	}
+	if parsed.JSON200 == nil {
+		return nil, unexpectedBody(res, parsed.Body)
+	}
+	return *parsed.JSON200, nil
+}
+
+func (c *BannerClient) CreateTag(ctx context.Context, input TagInput) (*Tag, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.PostTags(ctx, &PostTagsParams{}, input)
+
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckStatus(res, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	parsed, err := ParsePostTagsResponse(res)
+
+	if err != nil {
+		return nil, err
+	}
+	if parsed.JSON201 == nil {
+		return nil, unexpectedBody(res, parsed.Body)
+	}
+	return parsed.JSON201, nil
+}
+
+// Background job, such as bulk delete started by the API
+func (c *BannerClient) Job(ctx context.Context, id int) (*Job, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.GetJobsId(ctx, id, &GetJobsIdParams{})
+
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckStatus(res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	parsed, err := ParseGetJobsIdResponse(res)
+
+	if err != nil {
+		return nil, err
+	}
+	if parsed.JSON200 == nil {
+		return nil, unexpectedBody(res, parsed.Body)
+	}
+	return parsed.JSON200, nil
+}
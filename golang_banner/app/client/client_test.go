@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *BannerClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	opts = append([]Option{WithRetries(2, time.Millisecond)}, opts...)
+	client, err := New(server.URL, "IMACREEP", opts...)
+
+	if err != nil {
+		t.Fatalf("Error occcured: %s", err.Error())
+	}
+	return client
+}
+
+func TestUserBannerCache(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, "IMACREEP", r.Header.Get("token"))
+		assert.Equal(t, "/user_banner", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"title": "a"}`))
+	})
+
+	content, err := client.UserBanner(context.Background(), 2, 3, 1)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{"title": "a"}, content)
+	}
+
+	// Changing returned banner does not change the cached one
+	content["title"] = "b"
+	content, err = client.UserBanner(context.Background(), 2, 1, 3)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "a", content["title"])
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	_, err = client.FreshUserBanner(context.Background(), 2, 1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestUserBannerCacheExpires(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"title": "a"}`))
+	}, WithUserBannerTTL(10*time.Millisecond))
+
+	_, err := client.UserBanner(context.Background(), 2, 3)
+	assert.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = client.UserBanner(context.Background(), 2, 3)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestRetries(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": 1, "name": "beta"}]`))
+	})
+
+	tags, err := client.Tags(context.Background(), nil)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "beta", tags[0].Name)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestRetriesGiveUp(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`"connection refused"`))
+	})
+
+	_, err := client.Tags(context.Background(), nil)
+
+	var apiErr *APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+		assert.Equal(t, "connection refused", apiErr.Message)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	// Creation could have happened, so it is not repeated
+	_, err = client.CreateTag(context.Background(), TagInput{Name: "beta"})
+	assert.Error(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+}
+
+func TestTypedErrors(t *testing.T) {
+	for status, target := range map[int]error{
+		http.StatusUnauthorized: ErrUnauthorized,
+		http.StatusForbidden:    ErrForbidden,
+		http.StatusNotFound:     ErrNotFound,
+	} {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		})
+
+		_, err := client.UserBanner(context.Background(), 2, 3)
+		assert.ErrorIs(t, err, target)
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": "invalid request body", "fields": [{"field": "name", "message": "is required"}]}`))
+	})
+
+	_, err := client.CreateFeature(context.Background(), FeatureInput{})
+
+	var apiErr *APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, []FieldError{{Field: "name", Message: "is required"}}, apiErr.Fields)
+		assert.False(t, errors.Is(err, ErrNotFound))
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}, WithTimeout(20*time.Millisecond))
+
+	_, err := client.Job(context.Background(), 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestBanners(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/banner", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "5")
+		w.Header().Set("X-Next-Cursor", "next")
+		w.Write([]byte(`[{"banner_id": 1}, {"banner_id": 2}]`))
+	})
+
+	limit := 2
+	page, err := client.Banners(context.Background(), &GetBannerParams{Limit: &limit})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, []map[string]interface{}{{"banner_id": float64(1)}, {"banner_id": float64(2)}}, page.Banners)
+		assert.Equal(t, "next", page.NextCursor)
+		assert.Equal(t, 5, page.TotalCount)
+	}
+}
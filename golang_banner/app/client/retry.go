@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// Doer that retries requests failed with network error or 5xx status. Only requests that can be repeated
+// safely are retried: GET, HEAD, PUT and DELETE, and requests with If-Match, which can't be applied twice
+type retryDoer struct {
+	doer       HttpRequestDoer
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := d.doer.Do(req)
+
+		if attempt >= d.maxRetries || !retryable(req, res, err) {
+			return res, err
+		}
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if err := sleep(req.Context(), retryDelay(d.baseDelay, attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func retryable(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	default:
+		if req.Header.Get("If-Match") == "" {
+			return false
+		}
+	}
+	return err != nil || res.StatusCode >= 500
+}
+
+// Random delay up to baseDelay*2^attempt, so clients that failed together do not retry together
+func retryDelay(baseDelay time.Duration, attempt int) time.Duration {
+	limit := baseDelay << attempt
+	if limit <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(limit)))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,405 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Redych-the-kid/go_projects/golang_banner/client"
+)
+
+// Filters of GET /banner, shared with export
+type bannerFilter struct {
+	featureIDs  intList
+	tagIDs      intList
+	tagMatch    **string
+	isActive    **bool
+	createdFrom **time.Time
+	createdTo   **time.Time
+	updatedFrom **time.Time
+	updatedTo   **time.Time
+	q           **string
+	contentPath **string
+}
+
+func newBannerFilter(fs *flag.FlagSet) *bannerFilter {
+	f := &bannerFilter{}
+	fs.Var(&f.featureIDs, "feature-id", "feature id, repeatable")
+	fs.Var(&f.tagIDs, "tag-id", "tag id, repeatable")
+	f.tagMatch = optionalString(fs, "tag-match", "any or all tags of --tag-id")
+	f.isActive = optionalBool(fs, "active", "banner is active")
+	f.createdFrom = optionalTime(fs, "created-from", "created at or after")
+	f.createdTo = optionalTime(fs, "created-to", "created before")
+	f.updatedFrom = optionalTime(fs, "updated-from", "updated at or after")
+	f.updatedTo = optionalTime(fs, "updated-to", "updated before")
+	f.q = optionalString(fs, "q", "full text search in content")
+	f.contentPath = optionalString(fs, "content-path", "SQL/JSONPath content must match")
+	return f
+}
+
+func (f *bannerFilter) ids() (*[]int, *[]int) {
+	var featureIDs, tagIDs *[]int
+	if len(f.featureIDs) > 0 {
+		ids := []int(f.featureIDs)
+		featureIDs = &ids
+	}
+	if len(f.tagIDs) > 0 {
+		ids := []int(f.tagIDs)
+		tagIDs = &ids
+	}
+	return featureIDs, tagIDs
+}
+
+func runList(a *app, args []string) error {
+	fs := newFlagSet("list", a.stderr)
+	filter := newBannerFilter(fs)
+	limit := optionalInt(fs, "limit", "page size")
+	offset := optionalInt(fs, "offset", "banners to skip")
+	sort := optionalString(fs, "sort", "id, feature_id, created_at or updated_at")
+	order := optionalString(fs, "order", "asc or desc")
+	cursor := optionalString(fs, "cursor", "cursor of the next page")
+	state := optionalString(fs, "state", "draft, pending_review, approved, published or archived")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	params := &client.GetBannerParams{
+		TagMatch:    (*client.GetBannerParamsTagMatch)(*filter.tagMatch),
+		IsActive:    *filter.isActive,
+		CreatedFrom: *filter.createdFrom,
+		CreatedTo:   *filter.createdTo,
+		UpdatedFrom: *filter.updatedFrom,
+		UpdatedTo:   *filter.updatedTo,
+		Q:           *filter.q,
+		ContentPath: *filter.contentPath,
+		Limit:       *limit,
+		Offset:      *offset,
+		Sort:        (*client.GetBannerParamsSort)(*sort),
+		Order:       (*client.GetBannerParamsOrder)(*order),
+		Cursor:      *cursor,
+		State:       (*client.GetBannerParamsState)(*state),
+	}
+	params.FeatureId, params.TagId = filter.ids()
+
+	c, err := a.client()
+
+	if err != nil {
+		return err
+	}
+
+	page, err := c.Banners(a.ctx, params)
+
+	if err != nil {
+		return err
+	}
+	banners := page.Banners
+
+	err = a.print(banners, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "ID\tFEATURE\tTAGS\tACTIVE\tSTATE\tPRIORITY\tREVISION\tUPDATED")
+		for _, b := range banners {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", formatValue(b["id"]), formatValue(b["feature_id"]), formatValue(b["tag_ids"]),
+				formatValue(b["is_active"]), formatValue(b["state"]), formatValue(b["priority"]), formatValue(b["revision"]), formatTime(b["updated_at"]))
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+	if page.NextCursor != "" {
+		fmt.Fprintf(a.stderr, "next page: --cursor %s\n", page.NextCursor)
+	}
+	return nil
+}
+
+func runGet(a *app, args []string) error {
+	id, args, err := parseID(args)
+
+	if err != nil {
+		return err
+	}
+	if err := newFlagSet("get", a.stderr).Parse(args); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+
+	if err != nil {
+		return err
+	}
+
+	banner, _, err := c.Banner(a.ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return a.print(banner, func(w *tabwriter.Writer) {
+		for _, key := range sortedKeys(banner) {
+			if key == "content" {
+				continue
+			}
+			fmt.Fprintf(w, "%s:\t%s\n", key, formatValue(banner[key]))
+		}
+		content, _ := json.MarshalIndent(banner["content"], "", "  ")
+		fmt.Fprintf(w, "content:\n%s\n", content)
+	})
+}
+
+func runCreate(a *app, args []string) error {
+	fs := newFlagSet("create", a.stderr)
+	featureID := fs.Int("feature-id", 0, "feature id")
+	var tagIDs intList
+	fs.Var(&tagIDs, "tag-id", "tag id, repeatable")
+	active := fs.Bool("active", false, "create active banner")
+	priority := optionalInt(fs, "priority", "banner priority")
+	contentPath := fs.String("content", "", "file with content JSON, - for stdin. $EDITOR is opened without it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *featureID == 0 || len(tagIDs) == 0 {
+		return errors.New("--feature-id and --tag-id are required")
+	}
+
+	var content map[string]interface{}
+	var err error
+	if *contentPath != "" {
+		content, err = a.readContent(*contentPath)
+	} else {
+		content, err = a.editContent(map[string]interface{}{})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c, err := a.client()
+
+	if err != nil {
+		return err
+	}
+
+	id, err := c.CreateBanner(a.ctx, client.BannerInput{
+		Content:   content,
+		FeatureId: *featureID,
+		IsActive:  *active,
+		Priority:  *priority,
+		TagIds:    tagIDs,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	created := map[string]int{"banner_id": id}
+	return a.print(created, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "created banner %d\n", id)
+	})
+}
+
+func runPatch(a *app, args []string) error {
+	id, args, err := parseID(args)
+
+	if err != nil {
+		return err
+	}
+
+	fs := newFlagSet("patch", a.stderr)
+	contentPath := fs.String("content", "", "file with new content JSON, - for stdin")
+	edit := fs.Bool("edit", false, "edit current content in $EDITOR")
+	active := optionalBool(fs, "active", "banner is active")
+	priority := optionalInt(fs, "priority", "banner priority")
+	featureID := optionalInt(fs, "feature-id", "feature id")
+	var tagIDs intList
+	fs.Var(&tagIDs, "tag-id", "tag id, repeatable, replaces all tags")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *contentPath != "" && *edit {
+		return errors.New("--content and --edit can't be used together")
+	}
+
+	c, err := a.client()
+
+	if err != nil {
+		return err
+	}
+
+	// ETag makes the patch fail if someone changed banner since it was read
+	banner, etag, err := c.Banner(a.ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	patch := client.BannerPatch{IsActive: *active, Priority: *priority, FeatureId: *featureID}
+	if len(tagIDs) > 0 {
+		ids := []int(tagIDs)
+		patch.TagIds = &ids
+	}
+
+	current, _ := banner["content"].(map[string]interface{})
+	var content map[string]interface{}
+	if *contentPath != "" {
+		content, err = a.readContent(*contentPath)
+	} else if *edit {
+		content, err = a.editContent(current)
+	}
+
+	if err != nil {
+		return err
+	}
+	if content != nil {
+		if changes := mergePatch(current, content); len(changes) > 0 {
+			patch.Content = &changes
+		}
+	}
+
+	if reflect.DeepEqual(patch, client.BannerPatch{}) {
+		return errors.New("nothing to change")
+	}
+
+	etag, err = c.PatchBanner(a.ctx, id, patch, etag)
+
+	if err != nil {
+		return err
+	}
+
+	patched := map[string]interface{}{"banner_id": id, "etag": etag}
+	return a.print(patched, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "patched banner %d\n", id)
+	})
+}
+
+func runDelete(a *app, args []string) error {
+	id, args, err := parseID(args)
+
+	if err != nil {
+		return err
+	}
+	if err := newFlagSet("delete", a.stderr).Parse(args); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+
+	if err != nil {
+		return err
+	}
+
+	if err := c.DeleteBanner(a.ctx, id, ""); err != nil {
+		return err
+	}
+
+	deleted := map[string]int{"banner_id": id}
+	return a.print(deleted, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "moved banner %d to trash\n", id)
+	})
+}
+
+// Reads content JSON from file, - is stdin
+func (a *app) readContent(path string) (map[string]interface{}, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(a.stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return parseContent(data)
+}
+
+// Opens content in $VISUAL or $EDITOR and returns the saved one
+func (a *app) editContent(content map[string]interface{}) (map[string]interface{}, error) {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	data, err := json.MarshalIndent(content, "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.CreateTemp("", "banner-*.json")
+
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	// Editor may have arguments, for example "code --wait"
+	parts := strings.Fields(editor)
+	cmd := exec.Command(parts[0], append(parts[1:], file.Name())...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("editor: %w", err)
+	}
+
+	data, err = os.ReadFile(file.Name())
+
+	if err != nil {
+		return nil, err
+	}
+	return parseContent(data)
+}
+
+func parseContent(data []byte) (map[string]interface{}, error) {
+	var content map[string]interface{}
+	if err := json.Unmarshal(bytes.TrimSpace(data), &content); err != nil {
+		return nil, fmt.Errorf("content is not a JSON object: %w", err)
+	}
+	if content == nil {
+		return nil, errors.New("content is not a JSON object")
+	}
+	return content, nil
+}
+
+// JSON Merge Patch (RFC 7396) that turns before into after. Removed keys are set to null
+func mergePatch(before map[string]interface{}, after map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			patch[key] = nil
+		}
+	}
+	for key, value := range after {
+		old, ok := before[key]
+		if ok && reflect.DeepEqual(old, value) {
+			continue
+		}
+
+		oldObject, oldIsObject := old.(map[string]interface{})
+		object, isObject := value.(map[string]interface{})
+		if ok && oldIsObject && isObject {
+			patch[key] = mergePatch(oldObject, object)
+		} else {
+			patch[key] = value
+		}
+	}
+	return patch
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Config with a profile of server that serves handler
+func newTestServer(t *testing.T, handler http.HandlerFunc) string {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	code, _, stderr := runCtl(t, path, "", "profile", "set", "local", "--server", server.URL, "--token", "IGOTTHEPOWER!")
+
+	if code != 0 {
+		t.Fatalf("Error occcured: %s", stderr)
+	}
+	return path
+}
+
+func TestList(t *testing.T) {
+	path := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/banner", r.URL.Path)
+		assert.Equal(t, "IGOTTHEPOWER!", r.Header.Get("token"))
+		assert.Equal(t, []string{"1", "2"}, r.URL.Query()["feature_id"])
+		assert.Equal(t, "true", r.URL.Query().Get("is_active"))
+		assert.Equal(t, "2024-04-01T00:00:00Z", r.URL.Query().Get("updated_from"))
+		assert.Equal(t, "published", r.URL.Query().Get("state"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Next-Cursor", "abc")
+		w.Write([]byte(`[{"id": 4, "feature_id": 1, "tag_ids": [2, 3], "is_active": true, "state": "published", "priority": 0,
+			"revision": 1000000, "content": {"title": "a"}, "updated_at": "2024-04-10T12:00:00Z"}]`))
+	})
+
+	args := []string{"list", "--feature-id", "1,2", "--active", "true", "--updated-from", "2024-04-01T00:00:00Z", "--state", "published"}
+	code, stdout, stderr := runCtl(t, path, "", args...)
+	assert.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `^ID\s+FEATURE\s+TAGS\s+ACTIVE\s+STATE\s+PRIORITY\s+REVISION\s+UPDATED\n4\s+1\s+2,3\s+true\s+published\s+0\s+1000000\s+`, stdout)
+	assert.Contains(t, stderr, "--cursor abc")
+
+	code, stdout, _ = runCtl(t, path, "", append([]string{"-o", "json"}, args...)...)
+	assert.Equal(t, 0, code)
+
+	var banners []map[string]interface{}
+	if assert.NoError(t, json.Unmarshal([]byte(stdout), &banners)) {
+		assert.Equal(t, map[string]interface{}{"title": "a"}, banners[0]["content"])
+	}
+}
+
+func TestPatch(t *testing.T) {
+	var patch map[string]interface{}
+	path := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/banner/4", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("ETag", `"3"`)
+			w.Write([]byte(`{"id": 4, "revision": 3, "content": {"title": "a", "text": "b", "style": {"color": "red", "size": 1}}}`))
+		case http.MethodPatch:
+			assert.Equal(t, `"3"`, r.Header.Get("If-Match"))
+			assert.Equal(t, "application/merge-patch+json", r.Header.Get("Content-Type"))
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &patch))
+			w.Header().Set("ETag", `"4"`)
+		}
+	})
+
+	content := filepath.Join(t.TempDir(), "content.json")
+	os.WriteFile(content, []byte(`{"title": "a", "url": "https://example.com", "style": {"color": "blue", "size": 1}}`), 0o600)
+
+	code, stdout, stderr := runCtl(t, path, "", "patch", "4", "--content", content, "--priority", "5")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "patched banner 4\n", stdout)
+
+	// Only changes are sent, removed fields are null
+	assert.Equal(t, map[string]interface{}{
+		"priority": float64(5),
+		"content": map[string]interface{}{
+			"text":  nil,
+			"url":   "https://example.com",
+			"style": map[string]interface{}{"color": "blue"},
+		},
+	}, patch)
+
+	code, _, stderr = runCtl(t, path, "", "patch", "4")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "nothing to change")
+}
+
+func TestCreateFromEditor(t *testing.T) {
+	var input map[string]interface{}
+	path := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &input))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"banner_id": 7}`))
+	})
+
+	// Editor that writes content to the file it gets
+	editor := filepath.Join(t.TempDir(), "editor.sh")
+	os.WriteFile(editor, []byte("#!/bin/sh\necho '{\"title\": \"new\"}' > \"$1\"\n"), 0o700)
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", editor)
+
+	code, stdout, stderr := runCtl(t, path, "", "create", "--feature-id", "1", "--tag-id", "2", "--tag-id", "3", "--active")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "created banner 7\n", stdout)
+	assert.Equal(t, map[string]interface{}{
+		"feature_id": float64(1),
+		"tag_ids":    []interface{}{float64(2), float64(3)},
+		"is_active":  true,
+		"content":    map[string]interface{}{"title": "new"},
+	}, input)
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Server the CLI talks to and the token it authorizes with
+type profile struct {
+	Server string `json:"server"`
+	Token  string `json:"token,omitempty"`
+}
+
+// Config file with named profiles. It holds tokens, so it is readable by owner only
+type config struct {
+	Current  string             `json:"current,omitempty"`
+	Profiles map[string]profile `json:"profiles"`
+}
+
+// $BANNERCTL_CONFIG or bannerctl/config.json in user config directory
+func defaultConfigPath() string {
+	if path := os.Getenv("BANNERCTL_CONFIG"); path != "" {
+		return path
+	}
+
+	dir, err := os.UserConfigDir()
+
+	if err != nil {
+		return "bannerctl.json"
+	}
+	return filepath.Join(dir, "bannerctl", "config.json")
+}
+
+// Reads config. Missing file is an empty config
+func loadConfig(path string) (*config, error) {
+	cfg := &config{Profiles: make(map[string]profile)}
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cfg, nil
+		} else {
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = make(map[string]profile)
+	}
+	return cfg, nil
+}
+
+// Writes config through a temporary file, so a failed write does not lose profiles
+func (c *config) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (c *config) profileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Profile selected by --profile or the current one
+func (a *app) profileName() (string, error) {
+	name := a.profileFlag
+	if name == "" {
+		name = a.config.Current
+	}
+	if name == "" {
+		return "", errors.New("no profile selected, add one with 'bannerctl profile set NAME --server URL'")
+	}
+	if _, ok := a.config.Profiles[name]; !ok {
+		return "", fmt.Errorf("profile %q does not exist", name)
+	}
+	return name, nil
+}
+
+func runProfile(a *app, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: bannerctl profile list | set NAME --server URL [--token TOKEN] | use NAME | remove NAME")
+	}
+
+	switch args[0] {
+	case "list":
+		type listed struct {
+			Name    string `json:"name"`
+			Server  string `json:"server"`
+			Current bool   `json:"current"`
+		}
+		var profiles []listed
+		for _, name := range a.config.profileNames() {
+			profiles = append(profiles, listed{Name: name, Server: a.config.Profiles[name].Server, Current: name == a.config.Current})
+		}
+		return a.print(profiles, func(w *tabwriter.Writer) {
+			fmt.Fprintln(w, "\tNAME\tSERVER")
+			for _, p := range profiles {
+				current := ""
+				if p.Current {
+					current = "*"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", current, p.Name, p.Server)
+			}
+		})
+	case "set":
+		fs := newFlagSet("profile set", a.stderr)
+		server := fs.String("server", "", "server URL, for example http://127.0.0.1:8080")
+		token := fs.String("token", "", "token, see also 'bannerctl token set'")
+		name, err := parseWithName(fs, args[1:])
+
+		if err != nil {
+			return err
+		}
+
+		p := a.config.Profiles[name]
+		if *server != "" {
+			p.Server = strings.TrimRight(*server, "/")
+		}
+		if *token != "" {
+			p.Token = *token
+		}
+		if p.Server == "" {
+			return errors.New("--server is required for a new profile")
+		}
+		a.config.Profiles[name] = p
+		// The first profile becomes current
+		if a.config.Current == "" {
+			a.config.Current = name
+		}
+		return a.config.save(a.configPath)
+	case "use":
+		name, err := parseWithName(newFlagSet("profile use", a.stderr), args[1:])
+
+		if err != nil {
+			return err
+		}
+		if _, ok := a.config.Profiles[name]; !ok {
+			return fmt.Errorf("profile %q does not exist", name)
+		}
+		a.config.Current = name
+		return a.config.save(a.configPath)
+	case "remove":
+		name, err := parseWithName(newFlagSet("profile remove", a.stderr), args[1:])
+
+		if err != nil {
+			return err
+		}
+		if _, ok := a.config.Profiles[name]; !ok {
+			return fmt.Errorf("profile %q does not exist", name)
+		}
+		delete(a.config.Profiles, name)
+		if a.config.Current == name {
+			a.config.Current = ""
+		}
+		return a.config.save(a.configPath)
+	default:
+		return fmt.Errorf("unknown profile command %q", args[0])
+	}
+}
+
+// Tokens are issued by the server operators, the CLI only keeps them in profiles and checks their role
+func runToken(a *app, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: bannerctl token set [TOKEN] | show [--reveal] | check | remove")
+	}
+
+	switch args[0] {
+	case "set":
+		fs := newFlagSet("token set", a.stderr)
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		name, err := a.profileName()
+
+		if err != nil {
+			return err
+		}
+
+		token := fs.Arg(0)
+		// Reading from stdin keeps the token out of shell history
+		if token == "" {
+			data, err := io.ReadAll(a.stdin)
+
+			if err != nil {
+				return err
+			}
+			token = strings.TrimSpace(string(data))
+		}
+		if token == "" {
+			return errors.New("token is empty")
+		}
+
+		p := a.config.Profiles[name]
+		p.Token = token
+		a.config.Profiles[name] = p
+		return a.config.save(a.configPath)
+	case "show":
+		fs := newFlagSet("token show", a.stderr)
+		reveal := fs.Bool("reveal", false, "show the whole token")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		name, err := a.profileName()
+
+		if err != nil {
+			return err
+		}
+
+		token := a.config.Profiles[name].Token
+		if !*reveal {
+			token = maskToken(token)
+		}
+		fmt.Fprintln(a.stdout, token)
+		return nil
+	case "check":
+		role, err := a.tokenRole()
+
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(a.stdout, role)
+		return nil
+	case "remove":
+		name, err := a.profileName()
+
+		if err != nil {
+			return err
+		}
+
+		p := a.config.Profiles[name]
+		p.Token = ""
+		a.config.Profiles[name] = p
+		return a.config.save(a.configPath)
+	default:
+		return fmt.Errorf("unknown token command %q", args[0])
+	}
+}
+
+// Keeps the last 4 characters, enough to tell tokens apart
+func maskToken(token string) string {
+	if len(token) <= 4 {
+		return strings.Repeat("*", len(token))
+	}
+	return strings.Repeat("*", len(token)-4) + token[len(token)-4:]
+}
+
+func newFlagSet(name string, output io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(output)
+	return fs
+}
+
+// Parses flags of a command that takes a single name argument. Flags may come after the name
+func parseWithName(fs *flag.FlagSet, args []string) (string, error) {
+	name := ""
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if name == "" {
+		name = fs.Arg(0)
+	}
+	if name == "" {
+		return "", fmt.Errorf("usage: bannerctl %s NAME", fs.Name())
+	}
+	return name, nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Runs bannerctl with config in a temporary directory and returns exit code, stdout and stderr
+func runCtl(t *testing.T, configPath string, stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"-config", configPath}, args...)
+	code := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bannerctl", "config.json")
+
+	code, _, stderr := runCtl(t, path, "", "profile", "set", "local", "--server", "http://127.0.0.1:8080/", "--token", "IGOTTHEPOWER!")
+	assert.Equal(t, 0, code, stderr)
+	code, _, stderr = runCtl(t, path, "", "profile", "set", "prod", "--server", "https://banners.example.com")
+	assert.Equal(t, 0, code, stderr)
+
+	info, err := os.Stat(path)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	cfg, err := loadConfig(path)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "local", cfg.Current)
+		assert.Equal(t, profile{Server: "http://127.0.0.1:8080", Token: "IGOTTHEPOWER!"}, cfg.Profiles["local"])
+	}
+
+	code, _, _ = runCtl(t, path, "", "profile", "use", "prod")
+	assert.Equal(t, 0, code)
+	code, stdout, _ := runCtl(t, path, "", "profile", "list")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `(?m)^\s+local\s+http://127.0.0.1:8080$`, stdout)
+	assert.Regexp(t, `(?m)^\*\s+prod\s+https://banners.example.com$`, stdout)
+
+	code, _, stderr = runCtl(t, path, "", "profile", "use", "staging")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `profile "staging" does not exist`)
+
+	code, _, _ = runCtl(t, path, "", "profile", "remove", "prod")
+	assert.Equal(t, 0, code)
+	cfg, err = loadConfig(path)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "", cfg.Current)
+		assert.Len(t, cfg.Profiles, 1)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/audit", r.URL.Path)
+		switch r.Header.Get("token") {
+		case "IGOTTHEPOWER!":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte("[]"))
+		case "IMACREEP":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	code, _, _ := runCtl(t, path, "", "profile", "set", "local", "--server", server.URL)
+	assert.Equal(t, 0, code)
+
+	// Token is read from stdin when it is not an argument
+	code, _, stderr := runCtl(t, path, "IGOTTHEPOWER!\n", "token", "set")
+	assert.Equal(t, 0, code, stderr)
+	_, stdout, _ := runCtl(t, path, "", "token", "show")
+	assert.Equal(t, "*********WER!\n", stdout)
+	_, stdout, _ = runCtl(t, path, "", "token", "show", "--reveal")
+	assert.Equal(t, "IGOTTHEPOWER!\n", stdout)
+	_, stdout, _ = runCtl(t, path, "", "token", "check")
+	assert.Equal(t, "admin\n", stdout)
+
+	_, stdout, _ = runCtl(t, path, "", "-token", "IMACREEP", "token", "check")
+	assert.Equal(t, "user\n", stdout)
+
+	code, _, stderr = runCtl(t, path, "", "-token", "nope", "token", "check")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "bannerctl: ")
+
+	code, _, _ = runCtl(t, path, "", "token", "remove")
+	assert.Equal(t, 0, code)
+	code, _, stderr = runCtl(t, path, "", "list")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "no token")
+}
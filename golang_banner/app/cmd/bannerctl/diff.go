@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Redych-the-kid/go_projects/golang_banner/client"
+)
+
+// Largest page of GET /audit
+const auditPageSize = 1000
+
+const (
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorBold  = "\x1b[1m"
+	colorReset = "\x1b[0m"
+)
+
+// Banner fields audit log keeps the changes of
+var auditedFields = []string{"tag_ids", "feature_id", "content", "is_active", "priority", "state", "deleted_at"}
+
+// Banner fields at some revision and the audit entry that produced it. Oldest revision may have no entry
+type bannerRevision struct {
+	Revision int                    `json:"revision"`
+	Banner   map[string]interface{} `json:"banner"`
+	Entry    *client.AuditEntry     `json:"entry,omitempty"`
+}
+
+// There is no API for old revisions, so they are rebuilt from the current banner by undoing audited changes
+// from the newest one. Every audited change is one revision. Returns revisions from the oldest
+func bannerRevisions(current map[string]interface{}, revision int, entries []client.AuditEntry) []bannerRevision {
+	banner := make(map[string]interface{})
+	for _, field := range auditedFields {
+		if value, ok := current[field]; ok {
+			banner[field] = value
+		}
+	}
+
+	revisions := make([]bannerRevision, len(entries)+1)
+	for i := range entries {
+		entry := entries[i]
+		revisions[len(entries)-i] = bannerRevision{Revision: revision, Banner: banner, Entry: &entry}
+
+		// Create diff has no state and deleted_at, but banner did not exist before it
+		previous := make(map[string]interface{}, len(banner))
+		if entry.Action != "create" {
+			for field, value := range banner {
+				previous[field] = value
+			}
+		}
+		for field, change := range entry.Diff {
+			if change.Before != nil {
+				previous[field] = *change.Before
+			} else {
+				delete(previous, field)
+			}
+		}
+		banner = previous
+		revision--
+	}
+	revisions[0] = bannerRevision{Revision: revision, Banner: banner}
+	return revisions
+}
+
+// All audit entries of banner, from the newest
+func (a *app) bannerAudit(c *client.BannerClient, id int) ([]client.AuditEntry, error) {
+	var entries []client.AuditEntry
+	limit := auditPageSize
+	params := &client.GetAuditParams{BannerId: &id, Limit: &limit}
+	for {
+		res, err := c.API().GetAudit(a.ctx, params)
+
+		if err != nil {
+			return nil, err
+		}
+		if err := client.CheckStatus(res, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		var page []client.AuditEntry
+		err = json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, page...)
+
+		next := res.Header.Get("X-Next-Cursor")
+		if next == "" {
+			return entries, nil
+		}
+		params.Cursor = &next
+	}
+}
+
+func runDiff(a *app, args []string) error {
+	id, args, err := parseID(args)
+
+	if err != nil {
+		return err
+	}
+
+	fs := newFlagSet("diff", a.stderr)
+	from := optionalInt(fs, "from", "old revision, the one before --to by default")
+	to := optionalInt(fs, "to", "new revision, the current one by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+
+	if err != nil {
+		return err
+	}
+
+	current, _, err := c.Banner(a.ctx, id)
+
+	if err != nil {
+		return err
+	}
+	revision, ok := current["revision"].(float64)
+	if !ok {
+		return fmt.Errorf("banner %d has no revision", id)
+	}
+
+	entries, err := a.bannerAudit(c, id)
+
+	if err != nil {
+		return err
+	}
+
+	revisions := bannerRevisions(current, int(revision), entries)
+	oldest, latest := revisions[0].Revision, revisions[len(revisions)-1].Revision
+	find := func(n int) (bannerRevision, error) {
+		if n < oldest || n > latest {
+			return bannerRevision{}, fmt.Errorf("revision %d is not in the audit log, known revisions are %d-%d", n, oldest, latest)
+		}
+		return revisions[n-oldest], nil
+	}
+
+	toRevision := latest
+	if *to != nil {
+		toRevision = **to
+	}
+	fromRevision := toRevision - 1
+	if *from != nil {
+		fromRevision = **from
+	}
+
+	before, err := find(fromRevision)
+
+	if err != nil {
+		return err
+	}
+
+	after, err := find(toRevision)
+
+	if err != nil {
+		return err
+	}
+
+	if a.output == "json" {
+		return a.print(map[string]interface{}{"banner_id": id, "from": before, "to": after}, nil)
+	}
+
+	beforeJSON, _ := json.MarshalIndent(before.Banner, "", "  ")
+	afterJSON, _ := json.MarshalIndent(after.Banner, "", "  ")
+	a.paint(colorBold, "--- revision %s\n", describeRevision(before))
+	a.paint(colorBold, "+++ revision %s\n", describeRevision(after))
+	writeDiff(a.stdout, strings.Split(string(beforeJSON), "\n"), strings.Split(string(afterJSON), "\n"), a.color)
+	return nil
+}
+
+func describeRevision(r bannerRevision) string {
+	if r.Entry == nil {
+		return fmt.Sprint(r.Revision)
+	}
+	return fmt.Sprintf("%d, %s by %s at %s", r.Revision, r.Entry.Action, r.Entry.Actor, r.Entry.CreatedAt.Local().Format("2006-01-02 15:04:05"))
+}
+
+// Prints formatted text in color when output is colored
+func (a *app) paint(color string, format string, args ...interface{}) {
+	if a.color {
+		fmt.Fprint(a.stdout, color)
+		defer fmt.Fprint(a.stdout, colorReset)
+	}
+	fmt.Fprintf(a.stdout, format, args...)
+}
+
+// Writes line diff of before and after based on their longest common subsequence.
+// Removed lines are red and start with -, added are green and start with +
+func writeDiff(w io.Writer, before []string, after []string, color bool) {
+	// lcs[i][j] is the length of the longest common subsequence of before[i:] and after[j:]
+	lcs := make([][]int, len(before)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(after)+1)
+	}
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i] == after[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	line := func(prefix string, text string, lineColor string) {
+		if color && lineColor != "" {
+			fmt.Fprintf(w, "%s%s %s%s\n", lineColor, prefix, text, colorReset)
+		} else {
+			fmt.Fprintf(w, "%s %s\n", prefix, text)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(before) || j < len(after) {
+		switch {
+		case i < len(before) && j < len(after) && before[i] == after[j]:
+			line(" ", before[i], "")
+			i++
+			j++
+		case j == len(after) || (i < len(before) && lcs[i+1][j] >= lcs[i][j+1]):
+			line("-", before[i], colorRed)
+			i++
+		default:
+			line("+", after[j], colorGreen)
+			j++
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteDiff(t *testing.T) {
+	var out bytes.Buffer
+	writeDiff(&out, []string{"{", `  "a": 1,`, `  "b": 2`, "}"}, []string{"{", `  "a": 1,`, `  "b": 3,`, `  "c": 4`, "}"}, false)
+	assert.Equal(t, strings.Join([]string{
+		"  {",
+		`    "a": 1,`,
+		`-   "b": 2`,
+		`+   "b": 3,`,
+		`+   "c": 4`,
+		"  }",
+		"",
+	}, "\n"), out.String())
+
+	out.Reset()
+	writeDiff(&out, []string{"a"}, []string{"b"}, true)
+	assert.Equal(t, "\x1b[31m- a\x1b[0m\n\x1b[32m+ b\x1b[0m\n", out.String())
+}
+
+func TestDiff(t *testing.T) {
+	path := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/banner/4":
+			w.Write([]byte(`{"id": 4, "revision": 3, "feature_id": 1, "tag_ids": [2], "is_active": true, "priority": 0,
+				"state": "draft", "content": {"title": "c"}, "created_at": "2024-04-10T12:00:00Z"}`))
+		case "/audit":
+			assert.Equal(t, "4", r.URL.Query().Get("banner_id"))
+			// Second page
+			if r.URL.Query().Get("cursor") == "next" {
+				w.Write([]byte(`[{"id": 1, "action": "create", "actor": "admin:1", "created_at": "2024-04-10T12:00:00Z", "diff": {
+					"tag_ids": {"after": [2]}, "feature_id": {"after": 1}, "content": {"after": {"title": "a"}},
+					"is_active": {"after": false}, "priority": {"after": 0}}}]`))
+				return
+			}
+			w.Header().Set("X-Next-Cursor", "next")
+			w.Write([]byte(`[
+				{"id": 3, "action": "update", "actor": "admin:1", "created_at": "2024-04-10T12:02:00Z",
+					"diff": {"content": {"before": {"title": "b"}, "after": {"title": "c"}}}},
+				{"id": 2, "action": "update", "actor": "admin:1", "created_at": "2024-04-10T12:01:00Z",
+					"diff": {"content": {"before": {"title": "a"}, "after": {"title": "b"}}, "is_active": {"before": false, "after": true}}}]`))
+		}
+	})
+
+	code, stdout, stderr := runCtl(t, path, "", "diff", "4")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "--- revision 2, update by admin:1")
+	assert.Contains(t, stdout, "+++ revision 3, update by admin:1")
+	assert.Contains(t, stdout, "\n-     \"title\": \"b\"\n+     \"title\": \"c\"\n")
+	assert.NotContains(t, stdout, "is_active\": false")
+
+	code, stdout, stderr = runCtl(t, path, "", "diff", "4", "--from", "1")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "\n-   \"is_active\": false,\n+   \"is_active\": true,\n")
+	assert.Contains(t, stdout, "\n-     \"title\": \"a\"\n")
+
+	// Revision before the creation is an empty banner
+	code, stdout, _ = runCtl(t, path, "", "diff", "4", "--from", "0", "--to", "1")
+	assert.Equal(t, 0, code)
+	assert.True(t, strings.HasPrefix(stdout, "--- revision 0\n+++ revision 1, create by admin:1"), stdout)
+	assert.Contains(t, stdout, "\n- {}\n")
+
+	code, _, stderr = runCtl(t, path, "", "diff", "4", "--to", "5")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "known revisions are 0-3")
+}
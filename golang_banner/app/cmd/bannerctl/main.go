@@ -0,0 +1,359 @@
+// bannerctl manages banners through the banner service API
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Redych-the-kid/go_projects/golang_banner/client"
+)
+
+const usage = `Usage: bannerctl [flags] COMMAND [ARGS]
+
+Commands:
+  list                   list banners, takes the same filters as GET /banner
+  get ID                 show banner
+  create                 create banner, content is read from --content file or $EDITOR
+  patch ID               change banner, content is read from --content file or edited with --edit
+  delete ID              move banner to trash
+  diff ID                show changes between banner revisions
+  export                 export banners as JSON Lines or CSV
+  import FILE            import banners exported by export
+  profile                list, set, use and remove server profiles
+  token                  set, show, check and remove token of the profile
+
+Flags:
+`
+
+// Command with arguments that follow its name
+type command func(a *app, args []string) error
+
+var commands = map[string]command{
+	"list":    runList,
+	"get":     runGet,
+	"create":  runCreate,
+	"patch":   runPatch,
+	"delete":  runDelete,
+	"diff":    runDiff,
+	"export":  runExport,
+	"import":  runImport,
+	"profile": runProfile,
+	"token":   runToken,
+}
+
+// State shared by commands
+type app struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	configPath  string
+	config      *config
+	profileFlag string
+	server      string
+	token       string
+	output      string
+	color       bool
+	ctx         context.Context
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// Runs command line and returns exit code
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	a := &app{stdin: stdin, stdout: stdout, stderr: stderr, ctx: ctx}
+
+	fs := newFlagSet("bannerctl", stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&a.configPath, "config", defaultConfigPath(), "config file with server profiles")
+	fs.StringVar(&a.profileFlag, "profile", "", "profile to use instead of the current one")
+	fs.StringVar(&a.server, "server", "", "server URL, overrides the profile")
+	fs.StringVar(&a.token, "token", "", "token, overrides the profile")
+	fs.StringVar(&a.output, "o", "table", "output format, table or json")
+	colorMode := fs.String("color", "auto", "colored output, auto, always or never")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(stderr, "bannerctl: unknown command %q\n", fs.Arg(0))
+		fs.Usage()
+		return 2
+	}
+	if a.output != "table" && a.output != "json" {
+		fmt.Fprintf(stderr, "bannerctl: unknown output format %q\n", a.output)
+		return 2
+	}
+
+	switch *colorMode {
+	case "always":
+		a.color = true
+	case "never":
+	case "auto":
+		a.color = isTerminal(stdout) && os.Getenv("NO_COLOR") == ""
+	default:
+		fmt.Fprintf(stderr, "bannerctl: unknown color mode %q\n", *colorMode)
+		return 2
+	}
+
+	config, err := loadConfig(a.configPath)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "bannerctl: %s\n", err.Error())
+		return 1
+	}
+	a.config = config
+
+	if err := cmd(a, fs.Args()[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "bannerctl: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := f.Stat()
+
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Client of the selected profile. --server and --token override the profile
+func (a *app) client() (*client.BannerClient, error) {
+	server, token := a.server, a.token
+	if server == "" || token == "" {
+		name, err := a.profileName()
+
+		if err != nil && server == "" {
+			return nil, err
+		}
+		if err == nil {
+			p := a.config.Profiles[name]
+			if server == "" {
+				server = p.Server
+			}
+			if token == "" {
+				token = p.Token
+			}
+		}
+	}
+	if token == "" {
+		return nil, errors.New("no token, set one with 'bannerctl token set'")
+	}
+	return client.New(server, token)
+}
+
+// Role of the token: admin, user or an error for tokens server does not accept
+func (a *app) tokenRole() (string, error) {
+	c, err := a.client()
+
+	if err != nil {
+		return "", err
+	}
+
+	// Audit is readable by admins only, so the status tells the role
+	limit := 1
+	res, err := c.API().GetAudit(a.ctx, &client.GetAuditParams{Limit: &limit})
+
+	if err != nil {
+		return "", err
+	}
+
+	err = client.CheckStatus(res, http.StatusOK)
+	if errors.Is(err, client.ErrForbidden) {
+		return "user", nil
+	} else if err != nil {
+		return "", err
+	}
+	res.Body.Close()
+	return "admin", nil
+}
+
+// Prints v as JSON with -o json, otherwise writes table
+func (a *app) print(v interface{}, table func(w *tabwriter.Writer)) error {
+	if a.output == "json" {
+		enc := json.NewEncoder(a.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	w := tabwriter.NewWriter(a.stdout, 0, 4, 2, ' ', 0)
+	table(w)
+	return w.Flush()
+}
+
+// Formats JSON value for table cell
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, value := range v {
+			values[i] = formatValue(value)
+		}
+		return strings.Join(values, ",")
+	case map[string]interface{}:
+		data, _ := json.Marshal(v)
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Formats RFC 3339 time of API for table cell
+func formatTime(v interface{}) string {
+	s, ok := v.(string)
+	if !ok {
+		return formatValue(v)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+
+	if err != nil {
+		return s
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
+
+// Parses banner id argument
+func parseID(args []string) (int, []string, error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return 0, nil, errors.New("banner id is required")
+	}
+
+	id, err := strconv.Atoi(args[0])
+
+	if err != nil || id <= 0 {
+		return 0, nil, fmt.Errorf("invalid banner id %q", args[0])
+	}
+	return id, args[1:], nil
+}
+
+// Repeatable integer flag, also takes comma separated values
+type intList []int
+
+func (l *intList) String() string {
+	values := make([]string, len(*l))
+	for i, v := range *l {
+		values[i] = strconv.Itoa(v)
+	}
+	return strings.Join(values, ",")
+}
+
+func (l *intList) Set(s string) error {
+	for _, part := range strings.Split(s, ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(part))
+
+		if err != nil {
+			return fmt.Errorf("invalid number %q", part)
+		}
+		*l = append(*l, v)
+	}
+	return nil
+}
+
+// Flag that is nil until set
+func optionalBool(fs *flag.FlagSet, name string, usage string) **bool {
+	var value *bool
+	fs.Func(name, usage+", true or false", func(s string) error {
+		v, err := strconv.ParseBool(s)
+
+		if err != nil {
+			return err
+		}
+		value = &v
+		return nil
+	})
+	return &value
+}
+
+func optionalInt(fs *flag.FlagSet, name string, usage string) **int {
+	var value *int
+	fs.Func(name, usage, func(s string) error {
+		v, err := strconv.Atoi(s)
+
+		if err != nil {
+			return err
+		}
+		value = &v
+		return nil
+	})
+	return &value
+}
+
+func optionalString(fs *flag.FlagSet, name string, usage string) **string {
+	var value *string
+	fs.Func(name, usage, func(s string) error {
+		value = &s
+		return nil
+	})
+	return &value
+}
+
+func optionalTime(fs *flag.FlagSet, name string, usage string) **time.Time {
+	var value *time.Time
+	fs.Func(name, usage+", RFC 3339", func(s string) error {
+		v, err := time.Parse(time.RFC3339, s)
+
+		if err != nil {
+			return err
+		}
+		value = &v
+		return nil
+	})
+	return &value
+}
+
+// Keys of map in order, for stable output
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Redych-the-kid/go_projects/golang_banner/client"
+)
+
+func runExport(a *app, args []string) error {
+	fs := newFlagSet("export", a.stderr)
+	filter := newBannerFilter(fs)
+	format := fs.String("format", "ndjson", "ndjson or csv")
+	path := fs.String("file", "-", "file to write, - for stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	params := &client.GetBannerExportParams{
+		TagMatch:    (*client.GetBannerExportParamsTagMatch)(*filter.tagMatch),
+		IsActive:    *filter.isActive,
+		CreatedFrom: *filter.createdFrom,
+		CreatedTo:   *filter.createdTo,
+		UpdatedFrom: *filter.updatedFrom,
+		UpdatedTo:   *filter.updatedTo,
+		Q:           *filter.q,
+		ContentPath: *filter.contentPath,
+		Format:      (*client.GetBannerExportParamsFormat)(format),
+	}
+	params.FeatureId, params.TagId = filter.ids()
+
+	c, err := a.client()
+
+	if err != nil {
+		return err
+	}
+
+	res, err := c.API().GetBannerExport(a.ctx, params)
+
+	if err != nil {
+		return err
+	}
+	if err := client.CheckStatus(res, http.StatusOK); err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// Export is streamed, so large exports are not kept in memory
+	if *path == "-" {
+		_, err = io.Copy(a.stdout, res.Body)
+		return err
+	}
+
+	file, err := os.Create(*path)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, res.Body); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func runImport(a *app, args []string) error {
+	fs := newFlagSet("import", a.stderr)
+	mode := fs.String("mode", "upsert", "upsert or skip_existing")
+	dryRun := fs.Bool("dry-run", false, "check import without saving changes")
+	path, err := parseWithName(fs, args)
+
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if path == "-" {
+		body = a.stdin
+	} else {
+		file, err := os.Open(path)
+
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		body = file
+	}
+
+	contentType := "application/x-ndjson"
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		contentType = "text/csv"
+	}
+
+	c, err := a.client()
+
+	if err != nil {
+		return err
+	}
+
+	params := &client.PostBannerImportParams{Mode: (*client.PostBannerImportParamsMode)(mode), DryRun: dryRun}
+	res, err := c.API().PostBannerImportWithBody(a.ctx, params, contentType, body)
+
+	if err != nil {
+		return err
+	}
+
+	// Import that failed has the report too, with the records that did not pass
+	failed := res.StatusCode == http.StatusUnprocessableEntity
+	if !failed {
+		if err := client.CheckStatus(res, http.StatusOK); err != nil {
+			return err
+		}
+	}
+	defer res.Body.Close()
+
+	var report client.BannerImportReport
+	if err := json.NewDecoder(res.Body).Decode(&report); err != nil {
+		return err
+	}
+
+	err = a.print(report, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "created %d, updated %d, unchanged %d, skipped %d, failed %d\n",
+			report.Created, report.Updated, report.Unchanged, report.Skipped, report.Failed)
+		if report.Failed == 0 {
+			return
+		}
+
+		fmt.Fprintln(w, "LINE\tID\tSTATUS\tERROR")
+		for _, record := range report.Records {
+			if record.Error == nil {
+				continue
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", record.Line, formatOptionalInt(record.Id), formatOptionalInt(record.Status), importRecordError(record))
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+	if failed {
+		return errors.New("import failed, no changes were saved")
+	}
+	if report.DryRun {
+		fmt.Fprintln(a.stderr, "dry run, no changes were saved")
+	}
+	return nil
+}
+
+func formatOptionalInt(v *int) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprint(*v)
+}
+
+// Error of record with its field errors
+func importRecordError(record client.BannerImportRecord) string {
+	message := *record.Error
+	if record.Fields != nil {
+		for _, field := range *record.Fields {
+			message += fmt.Sprintf("; %s: %s", field.Field, field.Message)
+		}
+	}
+	return message
+}
@@ -0,0 +1,372 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Schema report checks banners in batches of this size and lists at most maxSchemaReportBanners nonconforming banners
+const (
+	schemaReportBatchSize  = 500
+	maxSchemaReportBanners = 1000
+)
+
+// Parameters of schema report job
+type schemaReportParams struct {
+	FeatureID int `json:"feature_id"`
+}
+
+// Result of schema report job
+type schemaReportResult struct {
+	FeatureID     int                  `json:"feature_id"`
+	Checked       int                  `json:"checked"`
+	Nonconforming int                  `json:"nonconforming"`
+	Banners       []schemaReportBanner `json:"banners"`
+	// Set when more than maxSchemaReportBanners banners don't conform
+	Truncated bool `json:"truncated"`
+}
+
+// Banner that doesn't conform to the feature schema
+type schemaReportBanner struct {
+	BannerID int64        `json:"banner_id"`
+	Errors   []FieldError `json:"errors"`
+}
+
+func (s *Server) GetFeaturesIdSchema(ctx echo.Context, id int, params GetFeaturesIdSchemaParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	schema, writeErr, err := s.findContentSchema(id)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSONBlob(http.StatusOK, schema)
+}
+
+func (s *Server) PutFeaturesIdSchema(ctx echo.Context, id int, params PutFeaturesIdSchemaParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	data, err := readRequestBody(ctx.Request().Body)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	jobID, writeErr, err := s.setContentSchema(id, data)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	ctx.Response().Header().Set("Location", fmt.Sprintf("/jobs/%d", jobID))
+	return ctx.JSON(http.StatusOK, JobAccepted{JobId: jobID})
+}
+
+func (s *Server) DeleteFeaturesIdSchema(ctx echo.Context, id int, params DeleteFeaturesIdSchemaParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	writeErr, err := s.removeContentSchema(id)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Reads content schema of feature. Used by REST and gRPC APIs
+func (s *Server) findContentSchema(id int) ([]byte, *bannerWriteError, error) {
+	var schema []byte
+	err := s.db.QueryRow("SELECT content_schema FROM features WHERE id = $1", id).Scan(&schema)
+
+	if err == sql.ErrNoRows {
+		return nil, &bannerWriteError{status: http.StatusNotFound, message: "Фича не найдена"}, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	if schema == nil {
+		return nil, &bannerWriteError{status: http.StatusNotFound, message: "У фичи нет схемы"}, nil
+	}
+	return schema, nil, nil
+}
+
+// Checks and stores content schema of feature and queues report of banners that don't conform to it.
+// Returns id of the report job. Used by REST and gRPC APIs
+func (s *Server) setContentSchema(id int, data []byte) (int, *bannerWriteError, error) {
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return 0, invalidBannerError([]FieldError{{Field: "body", Message: "must be a JSON object"}}), nil
+	}
+	if _, err := compileContentSchema(data); err != nil {
+		return 0, invalidBannerError([]FieldError{{Field: "body", Message: err.Error()}}), nil
+	}
+
+	// Schema and its report job are stored together, so every stored schema gets a report
+	var jobID int
+	writeErr, err := s.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+		result, err := tx.Exec("UPDATE features SET content_schema = $2 WHERE id = $1", id, data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		updated, err := result.RowsAffected()
+
+		if err != nil {
+			return nil, err
+		}
+		if updated == 0 {
+			return &bannerWriteError{status: http.StatusNotFound, message: "Фича не найдена"}, nil
+		}
+
+		jobID, err = insertJob(tx, jobKindSchemaReport, schemaReportParams{FeatureID: id})
+		return nil, err
+	})
+
+	if err != nil || writeErr != nil {
+		return 0, writeErr, err
+	}
+	s.wakeJobWorker()
+	return jobID, nil, nil
+}
+
+// Drops content schema of feature. Used by REST and gRPC APIs
+func (s *Server) removeContentSchema(id int) (*bannerWriteError, error) {
+	result, err := s.db.Exec("UPDATE features SET content_schema = NULL WHERE id = $1 AND content_schema IS NOT NULL", id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := result.RowsAffected()
+
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return &bannerWriteError{status: http.StatusNotFound, message: "Фича не найдена или у неё нет схемы"}, nil
+	}
+	return nil, nil
+}
+
+// Compiles feature content schema. Schemas without $schema are draft 2020-12, external $ref are not loaded
+func compileContentSchema(data []byte) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("can't load %s, schema must not refer to other documents", url)
+	}
+
+	if err := compiler.AddResource("mem:///content.json", bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return compiler.Compile("mem:///content.json")
+}
+
+// Validates banner content against feature schema. Errors are reported by path, for example content.items[0].url
+func validateContent(schema *jsonschema.Schema, content map[string]interface{}) []FieldError {
+	err := schema.Validate(content)
+
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []FieldError{{Field: "content", Message: err.Error()}}
+	}
+
+	var errs []FieldError
+	var collect func(validationErr *jsonschema.ValidationError)
+	collect = func(validationErr *jsonschema.ValidationError) {
+		if len(validationErr.Causes) == 0 {
+			errs = append(errs, FieldError{Field: contentFieldPath(validationErr.InstanceLocation), Message: validationErr.Message})
+		}
+		for _, cause := range validationErr.Causes {
+			collect(cause)
+		}
+	}
+	collect(validationErr)
+	return errs
+}
+
+// Converts JSON pointer inside content to field path. Array indexes are written in brackets
+func contentFieldPath(pointer string) string {
+	path := "content"
+	if pointer == "" || pointer == "/" {
+		return path
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		if _, err := strconv.Atoi(token); err == nil {
+			path += "[" + token + "]"
+		} else {
+			path += "." + token
+		}
+	}
+	return path
+}
+
+// Validates content of banner against schema of its feature. Feature without schema accepts any content
+func checkBannerContent(tx *sql.Tx, input BannerInput) ([]FieldError, error) {
+	var data []byte
+	err := tx.QueryRow("SELECT content_schema FROM features WHERE id = $1", input.FeatureId).Scan(&data)
+
+	if err == sql.ErrNoRows || (err == nil && data == nil) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	schema, err := compileContentSchema(data)
+
+	if err != nil {
+		return nil, err
+	}
+	return validateContent(schema, input.Content), nil
+}
+
+// Checks live banners of feature against its schema and stores nonconforming banners as job result
+func (s *Server) runSchemaReport(ctx context.Context, jobID int, data []byte) error {
+	var params schemaReportParams
+
+	if err := json.Unmarshal(data, &params); err != nil {
+		return err
+	}
+
+	report := schemaReportResult{FeatureID: params.FeatureID, Banners: []schemaReportBanner{}}
+	var schemaData []byte
+	err := s.db.QueryRowContext(ctx, "SELECT content_schema FROM features WHERE id = $1", params.FeatureID).Scan(&schemaData)
+
+	if err == sql.ErrNoRows || (err == nil && schemaData == nil) {
+		return setJobResult(s.db, jobID, report)
+	} else if err != nil {
+		return err
+	}
+
+	schema, err := compileContentSchema(schemaData)
+
+	if err != nil {
+		return err
+	}
+
+	var total int
+	err = s.db.QueryRowContext(ctx, "SELECT count(*) FROM banners WHERE (feature_id = $1 OR published_feature_id = $1) AND deleted_at IS NULL", params.FeatureID).Scan(&total)
+
+	if err != nil {
+		return err
+	}
+	if err := setJobTotal(s.db, jobID, total); err != nil {
+		return err
+	}
+
+	var lastID int64
+	for {
+		checked, err := s.checkSchemaReportBatch(ctx, schema, params.FeatureID, &lastID, &report)
+
+		if err != nil {
+			return err
+		}
+		if checked == 0 {
+			return setJobResult(s.db, jobID, report)
+		}
+		if err := addJobProgress(s.db, jobID, checked); err != nil {
+			return err
+		}
+	}
+}
+
+// Validates stored banner content against schema
+func checkReportContent(schema *jsonschema.Schema, data []byte) []FieldError {
+	var content map[string]interface{}
+
+	if err := json.Unmarshal(data, &content); err != nil || content == nil {
+		return []FieldError{{Field: "content", Message: "must be a JSON object"}}
+	}
+	return validateContent(schema, content)
+}
+
+// Checks the next batch of banners after lastID and moves lastID forward. Returns number of checked banners.
+// Both the edited and the published revision of the feature are checked, users get the published one
+func (s *Server) checkSchemaReportBatch(ctx context.Context, schema *jsonschema.Schema, featureID int, lastID *int64, report *schemaReportResult) (int, error) {
+	query := `SELECT id, feature_id, content, published_feature_id, published_content FROM banners
+	WHERE (feature_id = $1 OR published_feature_id = $1) AND deleted_at IS NULL AND id > $2 ORDER BY id LIMIT $3`
+	rows, err := s.db.QueryContext(ctx, query, featureID, *lastID, schemaReportBatchSize)
+
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	checked := 0
+	for rows.Next() {
+		var id int64
+		var draftFeatureID int
+		var publishedFeatureID sql.NullInt64
+		var data, publishedData []byte
+		if err := rows.Scan(&id, &draftFeatureID, &data, &publishedFeatureID, &publishedData); err != nil {
+			return 0, err
+		}
+		*lastID = id
+		checked++
+
+		var errs []FieldError
+		if draftFeatureID == featureID {
+			errs = append(errs, checkReportContent(schema, data)...)
+		}
+		if publishedFeatureID.Valid && int(publishedFeatureID.Int64) == featureID && publishedData != nil {
+			for _, fieldErr := range checkReportContent(schema, publishedData) {
+				fieldErr.Field = "published_" + fieldErr.Field
+				errs = append(errs, fieldErr)
+			}
+		}
+		if len(errs) == 0 {
+			continue
+		}
+
+		report.Nonconforming++
+		if len(report.Banners) < maxSchemaReportBanners {
+			report.Banners = append(report.Banners, schemaReportBanner{BannerID: id, Errors: errs})
+		} else {
+			report.Truncated = true
+		}
+	}
+	report.Checked += checked
+	return checked, rows.Err()
+}
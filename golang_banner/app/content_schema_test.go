@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const testContentSchema = `{"type": "object", "required": ["url"], "properties": {"url": {"type": "string"}, "items": {"type": "array", "items": {"type": "object", "properties": {"n": {"type": "integer"}}}}}}`
+
+func TestValidateContent(t *testing.T) {
+	schema, err := compileContentSchema([]byte(testContentSchema))
+	assert.NoError(t, err)
+
+	assert.Empty(t, validateContent(schema, map[string]interface{}{"url": "https://example.com"}))
+
+	content := map[string]interface{}{"items": []interface{}{map[string]interface{}{"n": "x"}}}
+	assert.Equal(t, []FieldError{
+		{Field: "content", Message: "missing properties: 'url'"},
+		{Field: "content.items[0].n", Message: "expected integer, but got string"},
+	}, validateContent(schema, content))
+}
+
+func TestCompileContentSchemaRejectsRemoteRefs(t *testing.T) {
+	_, err := compileContentSchema([]byte(`{"$ref": "https://example.com/banner.json"}`))
+	assert.Error(t, err)
+
+	_, err = compileContentSchema([]byte(`{"type": 12}`))
+	assert.Error(t, err)
+}
+
+func TestContentFieldPath(t *testing.T) {
+	assert.Equal(t, "content", contentFieldPath(""))
+	assert.Equal(t, "content.url", contentFieldPath("/url"))
+	assert.Equal(t, "content.a/b.list[2]", contentFieldPath("/a~1b/list/2"))
+}
+
+func TestPostBannerContentSchema(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	expectBannerRefs(db_mock, 2, []int{1})
+	expectContentSchema(db_mock, 2, []byte(testContentSchema))
+	db_mock.ExpectRollback()
+
+	e := echo.New()
+	body := `{"tag_ids": [1], "feature_id": 2, "content": {"title": "no url"}, "is_active": true}`
+	req := httptest.NewRequest(http.MethodPost, "/banner", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBanner(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var response ValidationError
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, []FieldError{{Field: "content", Message: "missing properties: 'url'"}}, response.Fields)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPutFeaturesIdSchema(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	server.jobWakeup = make(chan struct{}, 1)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	db_mock.ExpectExec("UPDATE features SET content_schema = $2 WHERE id = $1").
+		WithArgs(2, []byte(testContentSchema)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	db_mock.ExpectQuery("INSERT INTO jobs (kind, params) VALUES ($1, $2) RETURNING id").
+		WithArgs(jobKindSchemaReport, []byte(`{"feature_id":2}`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	db_mock.ExpectCommit()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/features/2/schema", strings.NewReader(testContentSchema))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	if assert.NoError(t, wrapper.PutFeaturesIdSchema(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "/jobs/5", rec.Header().Get("Location"))
+	}
+	assert.Len(t, server.jobWakeup, 1)
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPutFeaturesIdSchemaInvalid(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/features/2/schema", strings.NewReader(`{"type": "banner"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	if assert.NoError(t, wrapper.PutFeaturesIdSchema(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestRunSchemaReport(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+
+	db_mock.ExpectQuery("SELECT content_schema FROM features WHERE id = $1").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"content_schema"}).AddRow([]byte(testContentSchema)))
+	db_mock.ExpectQuery("SELECT count(*) FROM banners WHERE (feature_id = $1 OR published_feature_id = $1) AND deleted_at IS NULL").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	db_mock.ExpectExec("UPDATE jobs SET total = $2, updated_at = now() WHERE id = $1").
+		WithArgs(9, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	query := `SELECT id, feature_id, content, published_feature_id, published_content FROM banners
+	WHERE (feature_id = $1 OR published_feature_id = $1) AND deleted_at IS NULL AND id > $2 ORDER BY id LIMIT $3`
+	// Banner 8 was moved to another feature by an unpublished edit, users still get its old content
+	db_mock.ExpectQuery(query).
+		WithArgs(2, int64(0), schemaReportBatchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "feature_id", "content", "published_feature_id", "published_content"}).
+			AddRow(3, 2, []byte(`{"url": "https://example.com"}`), nil, nil).
+			AddRow(7, 2, []byte(`{"title": "no url"}`), 2, []byte(`{"url": "https://example.com"}`)).
+			AddRow(8, 4, []byte(`{"title": "other feature"}`), 2, []byte(`{"title": "no url"}`)))
+	db_mock.ExpectExec("UPDATE jobs SET processed = processed + $2, updated_at = now() WHERE id = $1").
+		WithArgs(9, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	db_mock.ExpectQuery(query).
+		WithArgs(2, int64(8), schemaReportBatchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "feature_id", "content", "published_feature_id", "published_content"}))
+	result := `{"feature_id":2,"checked":3,"nonconforming":2,"banners":[` +
+		`{"banner_id":7,"errors":[{"field":"content","message":"missing properties: 'url'"}]},` +
+		`{"banner_id":8,"errors":[{"field":"published_content","message":"missing properties: 'url'"}]}` +
+		`],"truncated":false}`
+	db_mock.ExpectExec("UPDATE jobs SET result = $2, updated_at = now() WHERE id = $1").
+		WithArgs(9, []byte(result)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, server.runSchemaReport(context.Background(), 9, []byte(`{"feature_id": 2}`)))
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
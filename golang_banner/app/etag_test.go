@@ -0,0 +1,154 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEtagMatches(t *testing.T) {
+	tests := []struct {
+		header string
+		etag   string
+		match  bool
+	}{
+		{header: `"3"`, etag: `"3"`, match: true},
+		{header: `"2", "3"`, etag: `"3"`, match: true},
+		{header: `W/"3"`, etag: `"3"`, match: true},
+		{header: `*`, etag: `"3"`, match: true},
+		{header: `"2"`, etag: `"3"`, match: false},
+		{header: ``, etag: `"3"`, match: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.match, etagMatches(test.header, test.etag), test.header)
+	}
+}
+
+func TestGetBannerIdETag(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	for _, ifNoneMatch := range []string{"", `"3"`} {
+		rows := sqlmock.NewRows([]string{"id", "tag_ids", "feature_id", "content", "is_active", "created_at", "updated_at", "revision", "priority", "deleted_at", "state", "published_revision", "published_at", "review_comment"}).
+			AddRow(7, "{2,3}", 2, []byte(`{"key": "value"}`), true, now, now, 3, 0, nil, "published", 1, now, nil)
+		db_mock.ExpectQuery("SELECT " + bannerColumns + " FROM banners WHERE id = $1 AND deleted_at IS NULL").
+			WithArgs(7).
+			WillReturnRows(rows)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/banner/7", nil)
+		req.Header.Set("token", "IGOTTHEPOWER!")
+
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("7")
+
+		if assert.NoError(t, wrapper.GetBannerId(c)) {
+			assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+			if ifNoneMatch != "" {
+				assert.Equal(t, http.StatusNotModified, rec.Code)
+			} else {
+				assert.Equal(t, http.StatusOK, rec.Code)
+			}
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPatchBannerStaleIfMatch(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	rows := sqlmock.NewRows([]string{"tag_ids", "feature_id", "content", "is_active", "priority", "revision"}).
+		AddRow("{2,3}", 2, []byte(`{"key": "value"}`), false, 0, 4)
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT tag_ids, feature_id, content, is_active, priority, revision FROM banners WHERE id = $1 AND deleted_at IS NULL FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(rows)
+	db_mock.ExpectRollback()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/banner/7", strings.NewReader(`{"is_active": true}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	req.Header.Set("If-Match", `"3"`)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	if assert.NoError(t, wrapper.PatchBannerId(c)) {
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteBannerRequiresIfMatch(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	server.requireIfMatch = true
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/banner/7", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	if assert.NoError(t, wrapper.DeleteBannerId(c)) {
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	}
+
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT revision FROM banners WHERE id = $1 AND deleted_at IS NULL").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(3))
+	db_mock.ExpectQuery("UPDATE banners SET deleted_at = now() WHERE id = $1 AND revision = $2 AND deleted_at IS NULL RETURNING deleted_at, published_feature_id").
+		WithArgs(7, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"deleted_at", "published_feature_id"}).AddRow(time.Now(), nil))
+	expectBannerChange(db_mock, auditDelete, 7)
+	db_mock.ExpectCommit()
+
+	req = httptest.NewRequest(http.MethodDelete, "/banner/7", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	req.Header.Set("If-Match", `"3"`)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	if assert.NoError(t, wrapper.DeleteBannerId(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBannerFilterBuilder(t *testing.T) {
+	features, tags := []int{1, 2}, []int{3, 4}
+	match, isActive := GetBannerParamsTagMatchAll, false
+	from := time.Date(2024, 4, 1, 3, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	q, path := "example.com", `$.url ? (@ like_regex "example\\.com")`
+	params := GetBannerParams{
+		FeatureId:   &features,
+		TagId:       &tags,
+		TagMatch:    &match,
+		IsActive:    &isActive,
+		CreatedFrom: &from,
+		Q:           &q,
+		ContentPath: &path,
+	}
+
+	query, args := bannerFilterBuilder(params)
+	assert.Equal(t, " WHERE deleted_at IS NULL AND feature_id = ANY($1::int[]) AND tag_ids @> $2::int[] AND is_active = $3"+
+		" AND created_at >= $4 AND to_tsvector('simple', content) @@ plainto_tsquery('simple', $5) AND content @? $6::jsonpath", query)
+	assert.Equal(t, []interface{}{pq.Array(features), pq.Array(tags), false, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), q, path}, args)
+
+	query, _ = bannerFilterBuilder(GetBannerParams{TagId: &tags})
+	assert.Equal(t, " WHERE deleted_at IS NULL AND tag_ids && $1::int[]", query)
+}
+
+func TestGetBannerFilters(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	db_mock.ExpectQuery("SELECT count(*) FROM banners WHERE deleted_at IS NULL AND feature_id = ANY($1::int[]) AND tag_ids && $2::int[]").
+		WithArgs(pq.Array([]int{1, 2}), pq.Array([]int{3})).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	rows := sqlmock.NewRows([]string{"id", "tag_ids", "feature_id", "content", "is_active", "created_at", "updated_at", "revision", "priority", "deleted_at", "state", "published_revision", "published_at", "review_comment"}).
+		AddRow(1, "{3}", 2, []byte(`{}`), true, now, now, 1, 0, nil, "published", 1, now, nil)
+	db_mock.ExpectQuery("SELECT "+bannerColumns+" FROM banners WHERE deleted_at IS NULL AND feature_id = ANY($1::int[]) AND tag_ids && $2::int[] ORDER BY id ASC").
+		WithArgs(pq.Array([]int{1, 2}), pq.Array([]int{3})).
+		WillReturnRows(rows)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner?feature_id=1&feature_id=2&tag_id=3", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBanner(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "1", rec.Header().Get("X-Total-Count"))
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetBannerInvalidContentPath(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectQuery("SELECT count(*) FROM banners WHERE deleted_at IS NULL AND content @? $1::jsonpath").
+		WithArgs("$.url ?").
+		WillReturnError(&pq.Error{Code: "42601", Message: "syntax error at end of jsonpath input"})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner?content_path=%24.url%20%3F", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBanner(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
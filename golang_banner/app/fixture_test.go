@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v8"
+)
+
+// Server with mocked database and cache shared by handler tests. Queries are matched exactly.
+// IGOTTHEPOWER! is admin token and IMACREEP is user token
+func newTestServer(t *testing.T) (*Server, sqlmock.Sqlmock, redismock.ClientMock) {
+	db, db_mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	cache, cache_mock := redismock.NewClientMock()
+	server := &Server{
+		tokens: map[string]string{
+			"IGOTTHEPOWER!": "admin",
+			"IMACREEP":      "user",
+		},
+		db:    db,
+		cache: cache,
+		ctx:   context.Background(),
+	}
+	return server, db_mock, cache_mock
+}
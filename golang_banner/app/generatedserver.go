@@ -4,96 +4,2868 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 )
 
-// GetBannerParams defines parameters for GetBanner.
-type GetBannerParams struct {
+// Defines values for BannerBulkItemOp.
+const (
+	Create BannerBulkItemOp = "create"
+	Patch  BannerBulkItemOp = "patch"
+)
+
+// Defines values for BannerBulkRequestMode.
+const (
+	Atomic     BannerBulkRequestMode = "atomic"
+	BestEffort BannerBulkRequestMode = "best_effort"
+)
+
+// Defines values for BannerImportRecordAction.
+const (
+	BannerImportRecordActionCreated   BannerImportRecordAction = "created"
+	BannerImportRecordActionFailed    BannerImportRecordAction = "failed"
+	BannerImportRecordActionSkipped   BannerImportRecordAction = "skipped"
+	BannerImportRecordActionUnchanged BannerImportRecordAction = "unchanged"
+	BannerImportRecordActionUpdated   BannerImportRecordAction = "updated"
+)
+
+// Defines values for JSONPatchOperationOp.
+const (
+	Add     JSONPatchOperationOp = "add"
+	Copy    JSONPatchOperationOp = "copy"
+	Move    JSONPatchOperationOp = "move"
+	Remove  JSONPatchOperationOp = "remove"
+	Replace JSONPatchOperationOp = "replace"
+	Test    JSONPatchOperationOp = "test"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Dead      WebhookDeliveryStatus = "dead"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEventType.
+const (
+	Activated WebhookEventType = "activated"
+	Created   WebhookEventType = "created"
+	Deleted   WebhookEventType = "deleted"
+	Updated   WebhookEventType = "updated"
+)
+
+// Defines values for GetBannerParamsTagMatch.
+const (
+	GetBannerParamsTagMatchAll GetBannerParamsTagMatch = "all"
+	GetBannerParamsTagMatchAny GetBannerParamsTagMatch = "any"
+)
+
+// Defines values for GetBannerParamsSort.
+const (
+	CreatedAt GetBannerParamsSort = "created_at"
+	FeatureId GetBannerParamsSort = "feature_id"
+	Id        GetBannerParamsSort = "id"
+	UpdatedAt GetBannerParamsSort = "updated_at"
+)
+
+// Defines values for GetBannerParamsOrder.
+const (
+	Asc  GetBannerParamsOrder = "asc"
+	Desc GetBannerParamsOrder = "desc"
+)
+
+// Defines values for GetBannerParamsState.
+const (
+	Approved      GetBannerParamsState = "approved"
+	Archived      GetBannerParamsState = "archived"
+	Draft         GetBannerParamsState = "draft"
+	PendingReview GetBannerParamsState = "pending_review"
+	Published     GetBannerParamsState = "published"
+)
+
+// Defines values for GetBannerParamsEmbed.
+const (
+	Names GetBannerParamsEmbed = "names"
+)
+
+// Defines values for GetBannerExportParamsTagMatch.
+const (
+	GetBannerExportParamsTagMatchAll GetBannerExportParamsTagMatch = "all"
+	GetBannerExportParamsTagMatchAny GetBannerExportParamsTagMatch = "any"
+)
+
+// Defines values for GetBannerExportParamsFormat.
+const (
+	Csv    GetBannerExportParamsFormat = "csv"
+	Ndjson GetBannerExportParamsFormat = "ndjson"
+)
+
+// Defines values for PostBannerImportParamsMode.
+const (
+	SkipExisting PostBannerImportParamsMode = "skip_existing"
+	Upsert       PostBannerImportParamsMode = "upsert"
+)
+
+// AuditChange defines model for AuditChange.
+type AuditChange struct {
+	// After Значение после изменения, отсутствует, если значения больше нет
+	After *interface{} `json:"after,omitempty"`
+
+	// Before Значение до изменения, отсутствует при создании баннера
+	Before *interface{} `json:"before,omitempty"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action Действие - create, update, delete, restore, submit, approve, reject, publish, archive или tokens
+	Action string `json:"action"`
+
+	// Actor Роль и отпечаток токена автора изменения, сам токен не хранится
+	Actor string `json:"actor"`
+
+	// BannerId Идентификатор изменённого баннера, отсутствует у записей tokens
+	BannerId  *int      `json:"banner_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Diff Изменённые поля баннера со значениями до и после изменения. У записей tokens поле tokens
+	// содержит роли и отпечатки принимаемых токенов до и после запуска сервиса
+	Diff map[string]AuditChange `json:"diff"`
+
+	// Id Идентификатор записи
+	Id int `json:"id"`
+
+	// RequestId Идентификатор запроса из заголовка X-Request-Id
+	RequestId *string `json:"request_id,omitempty"`
+
+	// SourceIp IP-адрес, с которого пришёл запрос
+	SourceIp *string `json:"source_ip,omitempty"`
+}
+
+// BannerBulkItem defines model for BannerBulkItem.
+type BannerBulkItem struct {
+	// Banner Для create - BannerInput, для patch - JSON Merge Patch с полями BannerPatch
+	Banner map[string]interface{} `json:"banner"`
+
+	// Id Идентификатор изменяемого баннера, только для patch
+	Id *int `json:"id,omitempty"`
+
+	// IfMatch ETag изменяемого баннера, только для patch
+	IfMatch *string `json:"if_match,omitempty"`
+
+	// Op Создание или изменение баннера
+	Op BannerBulkItemOp `json:"op"`
+}
+
+// BannerBulkItemOp Создание или изменение баннера
+type BannerBulkItemOp string
+
+// BannerBulkRequest defines model for BannerBulkRequest.
+type BannerBulkRequest struct {
+	Items []BannerBulkItem `json:"items"`
+
+	// Mode Режим применения элементов
+	Mode *BannerBulkRequestMode `json:"mode,omitempty"`
+}
+
+// BannerBulkRequestMode Режим применения элементов
+type BannerBulkRequestMode string
+
+// BannerBulkResponse defines model for BannerBulkResponse.
+type BannerBulkResponse struct {
+	// Applied Применён ли хотя бы один элемент
+	Applied bool               `json:"applied"`
+	Results []BannerBulkResult `json:"results"`
+}
+
+// BannerBulkResult defines model for BannerBulkResult.
+type BannerBulkResult struct {
+	// Error Описание ошибки
+	Error *string `json:"error,omitempty"`
+
+	// Etag ETag баннера после изменения
+	Etag   *string       `json:"etag,omitempty"`
+	Fields *[]FieldError `json:"fields,omitempty"`
+
+	// Id Идентификатор баннера
+	Id *int `json:"id,omitempty"`
+
+	// Index Номер элемента в запросе
+	Index int `json:"index"`
+
+	// Status HTTP-код, которым ответил бы POST /banner или PATCH /banner/{id}. 424 означает,
+	// что элемент не применён из-за ошибки другого элемента в режиме atomic
+	Status int `json:"status"`
+}
+
+// BannerConflict defines model for BannerConflict.
+type BannerConflict struct {
+	// BannerIds Баннеры пары, первым идёт тот, который видит пользователь
+	BannerIds []int `json:"banner_ids"`
+
+	// FeatureId Идентификатор фичи
+	FeatureId int `json:"feature_id"`
+
+	// TagId Идентификатор тэга
+	TagId int `json:"tag_id"`
+}
+
+// BannerImportRecord defines model for BannerImportRecord.
+type BannerImportRecord struct {
+	Action BannerImportRecordAction `json:"action"`
+	Error  *string                  `json:"error,omitempty"`
+	Fields *[]FieldError            `json:"fields,omitempty"`
+
+	// Id Идентификатор баннера
+	Id *int `json:"id,omitempty"`
+
+	// Line Номер строки записи во входных данных
+	Line int `json:"line"`
+
+	// Status HTTP-код, которым ответил бы POST /banner, для не прошедших записей
+	Status *int `json:"status,omitempty"`
+}
+
+// BannerImportRecordAction defines model for BannerImportRecord.Action.
+type BannerImportRecordAction string
+
+// BannerImportReport defines model for BannerImportReport.
+type BannerImportReport struct {
+	// Applied Изменения сохранены
+	Applied bool `json:"applied"`
+	Created int  `json:"created"`
+
+	// DryRun Импорт выполнен без сохранения изменений
+	DryRun bool `json:"dry_run"`
+	Failed int  `json:"failed"`
+
+	// Records Созданные, изменённые, пропущенные и не прошедшие записи
+	Records   []BannerImportRecord `json:"records"`
+	Skipped   int                  `json:"skipped"`
+	Unchanged int                  `json:"unchanged"`
+	Updated   int                  `json:"updated"`
+}
+
+// BannerInput defines model for BannerInput.
+type BannerInput struct {
+	// Content Содержимое баннера, не больше 64 КиБ
+	Content map[string]interface{} `json:"content"`
+
+	// FeatureId Идентификатор фичи
+	FeatureId int `json:"feature_id"`
+
+	// IsActive Флаг активности баннера
+	IsActive bool `json:"is_active"`
+
+	// Priority Приоритет баннера. Если пользователю подходят несколько баннеров, показывается баннер с наибольшим приоритетом
+	Priority *int `json:"priority,omitempty"`
+
+	// TagIds Идентификаторы тэгов
+	TagIds []int `json:"tag_ids"`
+}
+
+// BannerPatch defines model for BannerPatch.
+type BannerPatch struct {
+	// Content Изменения содержимого баннера. Поля со значением null удаляются
+	Content *map[string]interface{} `json:"content,omitempty"`
+
+	// FeatureId Идентификатор фичи
+	FeatureId *int `json:"feature_id,omitempty"`
+
+	// IsActive Флаг активности баннера
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// Priority Приоритет баннера. Если пользователю подходят несколько баннеров, показывается баннер с наибольшим приоритетом
+	Priority *int `json:"priority,omitempty"`
+
+	// TagIds Идентификаторы тэгов
+	TagIds *[]int `json:"tag_ids,omitempty"`
+}
+
+// ContentSchema JSON Schema содержимого баннера
+type ContentSchema map[string]interface{}
+
+// EntityRef defines model for EntityRef.
+type EntityRef struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Feature defines model for Feature.
+type Feature struct {
+	// Archived Архивные фичи нельзя указывать в новых баннерах
+	Archived    bool      `json:"archived"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+
+	// Id Идентификатор фичи
+	Id int `json:"id"`
+
+	// Name Уникальное имя фичи
+	Name string `json:"name"`
+
+	// Owner Ответственный за фичу
+	Owner     string    `json:"owner"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// FeatureInput defines model for FeatureInput.
+type FeatureInput struct {
+	Archived    *bool   `json:"archived,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	Owner       *string `json:"owner,omitempty"`
+}
+
+// FeaturePatch defines model for FeaturePatch.
+type FeaturePatch struct {
+	Archived    *bool   `json:"archived,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Owner       *string `json:"owner,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Поле запроса, например tag_ids[1]
+	Field string `json:"field"`
+
+	// Message Описание ошибки
+	Message string `json:"message"`
+}
+
+// JSONPatchOperation defines model for JSONPatchOperation.
+type JSONPatchOperation struct {
+	// From JSON Pointer источника для move и copy
+	From *string              `json:"from,omitempty"`
+	Op   JSONPatchOperationOp `json:"op"`
+
+	// Path JSON Pointer внутри content
+	Path string `json:"path"`
+
+	// Value Значение для add, replace и test
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// JSONPatchOperationOp defines model for JSONPatchOperation.Op.
+type JSONPatchOperationOp string
+
+// Job defines model for Job.
+type Job struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Error Причина ошибки задачи
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Id Идентификатор задачи
+	Id int `json:"id"`
+
+	// Kind Тип задачи
+	Kind string `json:"kind"`
+
+	// Params Параметры задачи
+	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// Processed Сколько объектов уже обработано
+	Processed int `json:"processed"`
+
+	// Result Результат завершённой задачи, если он есть у задачи этого типа
+	Result *map[string]interface{} `json:"result,omitempty"`
+
+	// Status Состояние задачи
+	Status JobStatus `json:"status"`
+
+	// Total Сколько объектов нужно обработать, известно после запуска задачи
+	Total     int       `json:"total"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// JobStatus Состояние задачи
+type JobStatus string
+
+// JobAccepted defines model for JobAccepted.
+type JobAccepted struct {
+	// JobId Идентификатор задачи
+	JobId int `json:"job_id"`
+}
+
+// Readiness defines model for Readiness.
+type Readiness struct {
+	// Errors Причины неготовности
+	Errors *[]string `json:"errors,omitempty"`
+
+	// ExpectedSchemaVersion Последняя миграция, встроенная в сервис
+	ExpectedSchemaVersion int  `json:"expected_schema_version"`
+	Ready                 bool `json:"ready"`
+
+	// SchemaVersion Последняя применённая миграция
+	SchemaVersion int `json:"schema_version"`
+}
+
+// ReviewDecision defines model for ReviewDecision.
+type ReviewDecision struct {
+	// Comment Комментарий ревьюера
+	Comment *string `json:"comment,omitempty"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	// Archived Архивные теги нельзя указывать в новых баннерах
+	Archived    bool      `json:"archived"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+
+	// Id Идентификатор тега
+	Id int `json:"id"`
+
+	// Name Уникальное имя тега
+	Name string `json:"name"`
+
+	// Owner Ответственный за тег
+	Owner     string    `json:"owner"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TagInput defines model for TagInput.
+type TagInput struct {
+	Archived    *bool   `json:"archived,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	Owner       *string `json:"owner,omitempty"`
+}
+
+// TagPatch defines model for TagPatch.
+type TagPatch struct {
+	Archived    *bool   `json:"archived,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Owner       *string `json:"owner,omitempty"`
+}
+
+// UserBannerBatchItem defines model for UserBannerBatchItem.
+type UserBannerBatchItem struct {
+	// FeatureId Идентификатор фичи
+	FeatureId int `json:"feature_id"`
+
+	// TagId Тэг пользователя
+	TagId int `json:"tag_id"`
+}
+
+// UserBannerBatchRequest defines model for UserBannerBatchRequest.
+type UserBannerBatchRequest struct {
+	Items []UserBannerBatchItem `json:"items"`
+
+	// UseLastRevision Получать актуальную информацию в обход кэша. Админ получает последние, ещё не опубликованные ревизии
+	UseLastRevision *bool `json:"use_last_revision,omitempty"`
+}
+
+// UserBannerBatchResponse defines model for UserBannerBatchResponse.
+type UserBannerBatchResponse struct {
+	Items []UserBannerBatchResult `json:"items"`
+}
+
+// UserBannerBatchResult defines model for UserBannerBatchResult.
+type UserBannerBatchResult struct {
+	// Content JSON-отображение баннера, если status равен 200
+	Content *json.RawMessage `json:"content,omitempty"`
+
+	// Error Описание ошибки, если status не равен 200
+	Error *string `json:"error,omitempty"`
+
+	// FeatureId Идентификатор фичи
+	FeatureId int `json:"feature_id"`
+
+	// Status HTTP-код результата для пары, 200, 403 или 404
+	Status int `json:"status"`
+
+	// TagId Тэг пользователя
+	TagId int `json:"tag_id"`
+}
+
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	Error  string       `json:"error"`
+	Fields []FieldError `json:"fields"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active    bool               `json:"active"`
+	CreatedAt time.Time          `json:"created_at"`
+	Events    []WebhookEventType `json:"events"`
+	Id        int                `json:"id"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	Url       string             `json:"url"`
+}
+
+// WebhookCreated defines model for WebhookCreated.
+type WebhookCreated struct {
+	Active    bool               `json:"active"`
+	CreatedAt time.Time          `json:"created_at"`
+	Events    []WebhookEventType `json:"events"`
+	Id        int                `json:"id"`
+
+	// Secret Секрет для проверки подписи
+	Secret    string    `json:"secret"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Url       string    `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Сколько раз событие пытались доставить
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+
+	// Event Тело запроса, которое получает подписчик
+	Event WebhookEvent `json:"event"`
+	Id    int          `json:"id"`
+
+	// LastError Причина последней неудачной попытки
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastStatus HTTP-код последнего ответа подписчика
+	LastStatus    *int                  `json:"last_status,omitempty"`
+	NextAttemptAt *time.Time            `json:"next_attempt_at,omitempty"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	WebhookId     int                   `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEvent Тело запроса, которое получает подписчик
+type WebhookEvent struct {
+	BannerId int `json:"banner_id"`
+
+	// Changes Изменённые поля баннера, как в журнале аудита
+	Changes map[string]AuditChange `json:"changes"`
+
+	// Id Идентификатор события. Событие может прийти повторно, подписчик отбрасывает дубликаты по нему
+	Id         int       `json:"id"`
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// Type created - баннер создан, updated - баннер изменён, deleted - баннер удалён,
+	// activated - опубликован активный баннер, который пользователи раньше не получали или получали выключенным.
+	// При активации отправляются и updated, и activated
+	Type WebhookEventType `json:"type"`
+}
+
+// WebhookEventType created - баннер создан, updated - баннер изменён, deleted - баннер удалён,
+// activated - опубликован активный баннер, который пользователи раньше не получали или получали выключенным.
+// При активации отправляются и updated, и activated
+type WebhookEventType string
+
+// WebhookInput defines model for WebhookInput.
+type WebhookInput struct {
+	// Active Неактивная подписка не получает новые события
+	Active *bool `json:"active,omitempty"`
+
+	// Events События, на которые оформлена подписка
+	Events []WebhookEventType `json:"events"`
+
+	// Secret Секрет для подписи. Если не задан, генерируется сервером
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Адрес http или https, на который отправляются события
+	Url string `json:"url"`
+}
+
+// WebhookPatch defines model for WebhookPatch.
+type WebhookPatch struct {
+	Active *bool               `json:"active,omitempty"`
+	Events *[]WebhookEventType `json:"events,omitempty"`
+	Secret *string             `json:"secret,omitempty"`
+	Url    *string             `json:"url,omitempty"`
+}
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	Actor    *string    `form:"actor,omitempty" json:"actor,omitempty"`
+	Action   *string    `form:"action,omitempty" json:"action,omitempty"`
+	BannerId *int       `form:"banner_id,omitempty" json:"banner_id,omitempty"`
+	From     *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit    *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor   *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// DeleteBannerParams defines parameters for DeleteBanner.
+type DeleteBannerParams struct {
 	FeatureId *int `form:"feature_id,omitempty" json:"feature_id,omitempty"`
 	TagId     *int `form:"tag_id,omitempty" json:"tag_id,omitempty"`
-	Limit     *int `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset    *int `form:"offset,omitempty" json:"offset,omitempty"`
 
-	// Token Токен админа
-	Token *string `json:"token,omitempty"`
-}
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetBannerParams defines parameters for GetBanner.
+type GetBannerParams struct {
+	// FeatureId Идентификаторы фич, например feature_id=1&feature_id=2. Подходит баннер любой из фич
+	FeatureId *[]int `form:"feature_id,omitempty" json:"feature_id,omitempty"`
+
+	// TagId Идентификаторы тегов, например tag_id=1&tag_id=5. Как они сочетаются, задаёт tag_match
+	TagId *[]int `form:"tag_id,omitempty" json:"tag_id,omitempty"`
+
+	// TagMatch any - у баннера есть хотя бы один из тегов, all - у баннера есть все теги
+	TagMatch    *GetBannerParamsTagMatch `form:"tag_match,omitempty" json:"tag_match,omitempty"`
+	IsActive    *bool                    `form:"is_active,omitempty" json:"is_active,omitempty"`
+	CreatedFrom *time.Time               `form:"created_from,omitempty" json:"created_from,omitempty"`
+	CreatedTo   *time.Time               `form:"created_to,omitempty" json:"created_to,omitempty"`
+	UpdatedFrom *time.Time               `form:"updated_from,omitempty" json:"updated_from,omitempty"`
+	UpdatedTo   *time.Time               `form:"updated_to,omitempty" json:"updated_to,omitempty"`
+
+	// Q Полнотекстовый поиск по значениям содержимого баннера, например q=example.com
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// ContentPath Выражение SQL/JSONPath, которому должно удовлетворять содержимое баннера,
+	// например $.url ? (@ like_regex "example\\.com")
+	ContentPath *string               `form:"content_path,omitempty" json:"content_path,omitempty"`
+	Limit       *int                  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset      *int                  `form:"offset,omitempty" json:"offset,omitempty"`
+	Sort        *GetBannerParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order       *GetBannerParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Cursor      *string               `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// State Состояние баннера в процессе ревью
+	State *GetBannerParamsState `form:"state,omitempty" json:"state,omitempty"`
+
+	// Embed names - добавить к баннерам поля feature и tags с именами фичи и тегов
+	Embed *GetBannerParamsEmbed `form:"embed,omitempty" json:"embed,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetBannerParamsTagMatch defines parameters for GetBanner.
+type GetBannerParamsTagMatch string
+
+// GetBannerParamsSort defines parameters for GetBanner.
+type GetBannerParamsSort string
+
+// GetBannerParamsOrder defines parameters for GetBanner.
+type GetBannerParamsOrder string
+
+// GetBannerParamsState defines parameters for GetBanner.
+type GetBannerParamsState string
+
+// GetBannerParamsEmbed defines parameters for GetBanner.
+type GetBannerParamsEmbed string
+
+// PostBannerParams defines parameters for PostBanner.
+type PostBannerParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PostBannerBulkParams defines parameters for PostBannerBulk.
+type PostBannerBulkParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetBannerConflictsParams defines parameters for GetBannerConflicts.
+type GetBannerConflictsParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetBannerExportParams defines parameters for GetBannerExport.
+type GetBannerExportParams struct {
+	// FeatureId Идентификаторы фич, например feature_id=1&feature_id=2. Подходит баннер любой из фич
+	FeatureId *[]int `form:"feature_id,omitempty" json:"feature_id,omitempty"`
+
+	// TagId Идентификаторы тегов, например tag_id=1&tag_id=5. Как они сочетаются, задаёт tag_match
+	TagId *[]int `form:"tag_id,omitempty" json:"tag_id,omitempty"`
+
+	// TagMatch any - у баннера есть хотя бы один из тегов, all - у баннера есть все теги
+	TagMatch    *GetBannerExportParamsTagMatch `form:"tag_match,omitempty" json:"tag_match,omitempty"`
+	IsActive    *bool                          `form:"is_active,omitempty" json:"is_active,omitempty"`
+	CreatedFrom *time.Time                     `form:"created_from,omitempty" json:"created_from,omitempty"`
+	CreatedTo   *time.Time                     `form:"created_to,omitempty" json:"created_to,omitempty"`
+	UpdatedFrom *time.Time                     `form:"updated_from,omitempty" json:"updated_from,omitempty"`
+	UpdatedTo   *time.Time                     `form:"updated_to,omitempty" json:"updated_to,omitempty"`
+
+	// Q Полнотекстовый поиск по значениям содержимого баннера, например q=example.com
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// ContentPath Выражение SQL/JSONPath, которому должно удовлетворять содержимое баннера,
+	// например $.url ? (@ like_regex "example\\.com")
+	ContentPath *string                      `form:"content_path,omitempty" json:"content_path,omitempty"`
+	Format      *GetBannerExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetBannerExportParamsTagMatch defines parameters for GetBannerExport.
+type GetBannerExportParamsTagMatch string
+
+// GetBannerExportParamsFormat defines parameters for GetBannerExport.
+type GetBannerExportParamsFormat string
+
+// PostBannerImportParams defines parameters for PostBannerImport.
+type PostBannerImportParams struct {
+	// Mode upsert - баннеры с существующим id обновляются, в том числе удалённые в корзину,
+	// skip_existing - баннеры с существующим id не меняются. Баннеры с новым id создаются с этим id
+	Mode *PostBannerImportParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// DryRun Проверить импорт и построить отчёт, не сохраняя изменения
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PostBannerImportParamsMode defines parameters for PostBannerImport.
+type PostBannerImportParamsMode string
+
+// GetBannerTrashParams defines parameters for GetBannerTrash.
+type GetBannerTrashParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// DeleteBannerIdParams defines parameters for DeleteBannerId.
+type DeleteBannerIdParams struct {
+	// Token Токен админа
+	Token   *string `json:"token,omitempty"`
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetBannerIdParams defines parameters for GetBannerId.
+type GetBannerIdParams struct {
+	// Token Токен админа
+	Token       *string `json:"token,omitempty"`
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PatchBannerIdApplicationJSONPatchPlusJSONBody defines parameters for PatchBannerId.
+type PatchBannerIdApplicationJSONPatchPlusJSONBody = []JSONPatchOperation
+
+// PatchBannerIdParams defines parameters for PatchBannerId.
+type PatchBannerIdParams struct {
+	// Token Токен админа
+	Token   *string `json:"token,omitempty"`
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostBannerIdApproveParams defines parameters for PostBannerIdApprove.
+type PostBannerIdApproveParams struct {
+	// Token Токен админа
+	Token   *string `json:"token,omitempty"`
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostBannerIdArchiveParams defines parameters for PostBannerIdArchive.
+type PostBannerIdArchiveParams struct {
+	// Token Токен админа
+	Token   *string `json:"token,omitempty"`
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostBannerIdPublishParams defines parameters for PostBannerIdPublish.
+type PostBannerIdPublishParams struct {
+	// Token Токен админа
+	Token   *string `json:"token,omitempty"`
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostBannerIdRejectParams defines parameters for PostBannerIdReject.
+type PostBannerIdRejectParams struct {
+	// Token Токен админа
+	Token   *string `json:"token,omitempty"`
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostBannerIdRestoreParams defines parameters for PostBannerIdRestore.
+type PostBannerIdRestoreParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PostBannerIdSubmitParams defines parameters for PostBannerIdSubmit.
+type PostBannerIdSubmitParams struct {
+	// Token Токен админа
+	Token   *string `json:"token,omitempty"`
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetFeaturesParams defines parameters for GetFeatures.
+type GetFeaturesParams struct {
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+	Limit    *int  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset   *int  `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PostFeaturesParams defines parameters for PostFeatures.
+type PostFeaturesParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// DeleteFeaturesIdParams defines parameters for DeleteFeaturesId.
+type DeleteFeaturesIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetFeaturesIdParams defines parameters for GetFeaturesId.
+type GetFeaturesIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PatchFeaturesIdParams defines parameters for PatchFeaturesId.
+type PatchFeaturesIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// DeleteFeaturesIdSchemaParams defines parameters for DeleteFeaturesIdSchema.
+type DeleteFeaturesIdSchemaParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetFeaturesIdSchemaParams defines parameters for GetFeaturesIdSchema.
+type GetFeaturesIdSchemaParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PutFeaturesIdSchemaParams defines parameters for PutFeaturesIdSchema.
+type PutFeaturesIdSchemaParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetJobsIdParams defines parameters for GetJobsId.
+type GetJobsIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetTagsParams defines parameters for GetTags.
+type GetTagsParams struct {
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+	Limit    *int  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset   *int  `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PostTagsParams defines parameters for PostTags.
+type PostTagsParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// DeleteTagsIdParams defines parameters for DeleteTagsId.
+type DeleteTagsIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetTagsIdParams defines parameters for GetTagsId.
+type GetTagsIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PatchTagsIdParams defines parameters for PatchTagsId.
+type PatchTagsIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetUserBannerParams defines parameters for GetUserBanner.
+type GetUserBannerParams struct {
+	// TagId Тэги пользователя, например tag_id=1&tag_id=5. Если подходят несколько баннеров,
+	// выбирается баннер с наибольшим priority, затем с наибольшим числом совпавших тэгов
+	// и наименьшим числом тэгов у баннера, затем последний обновлённый
+	TagId           []int `form:"tag_id" json:"tag_id"`
+	FeatureId       int   `form:"feature_id" json:"feature_id"`
+	UseLastRevision *bool `form:"use_last_revision,omitempty" json:"use_last_revision,omitempty"`
+
+	// Token Токен пользователя
+	Token       *string `json:"token,omitempty"`
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PostUserBannerBatchParams defines parameters for PostUserBannerBatch.
+type PostUserBannerBatchParams struct {
+	// Token Токен пользователя
+	Token *string `json:"token,omitempty"`
+}
+
+// GetUserBannerStreamParams defines parameters for GetUserBannerStream.
+type GetUserBannerStreamParams struct {
+	TagId     []int `form:"tag_id" json:"tag_id"`
+	FeatureId int   `form:"feature_id" json:"feature_id"`
+
+	// Token Токен пользователя
+	Token       *string `json:"token,omitempty"`
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetWebhooksParams defines parameters for GetWebhooks.
+type GetWebhooksParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PostWebhooksParams defines parameters for PostWebhooks.
+type PostWebhooksParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// DeleteWebhooksIdParams defines parameters for DeleteWebhooksId.
+type DeleteWebhooksIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetWebhooksIdParams defines parameters for GetWebhooksId.
+type GetWebhooksIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PatchWebhooksIdParams defines parameters for PatchWebhooksId.
+type PatchWebhooksIdParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// GetWebhooksIdDeadLettersParams defines parameters for GetWebhooksIdDeadLetters.
+type GetWebhooksIdDeadLettersParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PostWebhooksIdDeliveriesDeliveryIdRedeliverParams defines parameters for PostWebhooksIdDeliveriesDeliveryIdRedeliver.
+type PostWebhooksIdDeliveriesDeliveryIdRedeliverParams struct {
+	// Token Токен админа
+	Token *string `json:"token,omitempty"`
+}
+
+// PostBannerJSONRequestBody defines body for PostBanner for application/json ContentType.
+type PostBannerJSONRequestBody = BannerInput
+
+// PostBannerBulkJSONRequestBody defines body for PostBannerBulk for application/json ContentType.
+type PostBannerBulkJSONRequestBody = BannerBulkRequest
+
+// PatchBannerIdJSONRequestBody defines body for PatchBannerId for application/json ContentType.
+type PatchBannerIdJSONRequestBody = BannerPatch
+
+// PatchBannerIdApplicationJSONPatchPlusJSONRequestBody defines body for PatchBannerId for application/json-patch+json ContentType.
+type PatchBannerIdApplicationJSONPatchPlusJSONRequestBody = PatchBannerIdApplicationJSONPatchPlusJSONBody
+
+// PatchBannerIdApplicationMergePatchPlusJSONRequestBody defines body for PatchBannerId for application/merge-patch+json ContentType.
+type PatchBannerIdApplicationMergePatchPlusJSONRequestBody = BannerPatch
+
+// PostBannerIdApproveJSONRequestBody defines body for PostBannerIdApprove for application/json ContentType.
+type PostBannerIdApproveJSONRequestBody = ReviewDecision
+
+// PostBannerIdRejectJSONRequestBody defines body for PostBannerIdReject for application/json ContentType.
+type PostBannerIdRejectJSONRequestBody = ReviewDecision
+
+// PostFeaturesJSONRequestBody defines body for PostFeatures for application/json ContentType.
+type PostFeaturesJSONRequestBody = FeatureInput
+
+// PatchFeaturesIdJSONRequestBody defines body for PatchFeaturesId for application/json ContentType.
+type PatchFeaturesIdJSONRequestBody = FeaturePatch
+
+// PutFeaturesIdSchemaJSONRequestBody defines body for PutFeaturesIdSchema for application/json ContentType.
+type PutFeaturesIdSchemaJSONRequestBody = ContentSchema
+
+// PostTagsJSONRequestBody defines body for PostTags for application/json ContentType.
+type PostTagsJSONRequestBody = TagInput
+
+// PatchTagsIdJSONRequestBody defines body for PatchTagsId for application/json ContentType.
+type PatchTagsIdJSONRequestBody = TagPatch
+
+// PostUserBannerBatchJSONRequestBody defines body for PostUserBannerBatch for application/json ContentType.
+type PostUserBannerBatchJSONRequestBody = UserBannerBatchRequest
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = WebhookInput
+
+// PatchWebhooksIdJSONRequestBody defines body for PatchWebhooksId for application/json ContentType.
+type PatchWebhooksIdJSONRequestBody = WebhookPatch
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Получение журнала аудита
+	// (GET /audit)
+	GetAudit(ctx echo.Context, params GetAuditParams) error
+	// Массовое удаление баннеров фичи и/или тэга
+	// (DELETE /banner)
+	DeleteBanner(ctx echo.Context, params DeleteBannerParams) error
+	// Получение всех баннеров c фильтрацией по фичам, тегам, активности, датам и содержимому
+	// (GET /banner)
+	GetBanner(ctx echo.Context, params GetBannerParams) error
+	// Создание нового баннера
+	// (POST /banner)
+	PostBanner(ctx echo.Context, params PostBannerParams) error
+	// Массовое создание и изменение баннеров
+	// (POST /banner/bulk)
+	PostBannerBulk(ctx echo.Context, params PostBannerBulkParams) error
+	// Пары фича-тэг, которые покрывают несколько баннеров
+	// (GET /banner/conflicts)
+	GetBannerConflicts(ctx echo.Context, params GetBannerConflictsParams) error
+	// Выгрузка баннеров в JSON Lines или CSV
+	// (GET /banner/export)
+	GetBannerExport(ctx echo.Context, params GetBannerExportParams) error
+	// Загрузка баннеров из JSON Lines или CSV
+	// (POST /banner/import)
+	PostBannerImport(ctx echo.Context, params PostBannerImportParams) error
+	// Получение удалённых баннеров
+	// (GET /banner/trash)
+	GetBannerTrash(ctx echo.Context, params GetBannerTrashParams) error
+	// Удаление баннера по идентификатору
+	// (DELETE /banner/{id})
+	DeleteBannerId(ctx echo.Context, id int, params DeleteBannerIdParams) error
+	// Получение баннера по идентификатору
+	// (GET /banner/{id})
+	GetBannerId(ctx echo.Context, id int, params GetBannerIdParams) error
+	// Обновление содержимого баннера
+	// (PATCH /banner/{id})
+	PatchBannerId(ctx echo.Context, id int, params PatchBannerIdParams) error
+	// Одобрение баннера
+	// (POST /banner/{id}/approve)
+	PostBannerIdApprove(ctx echo.Context, id int, params PostBannerIdApproveParams) error
+	// Архивирование баннера
+	// (POST /banner/{id}/archive)
+	PostBannerIdArchive(ctx echo.Context, id int, params PostBannerIdArchiveParams) error
+	// Публикация баннера
+	// (POST /banner/{id}/publish)
+	PostBannerIdPublish(ctx echo.Context, id int, params PostBannerIdPublishParams) error
+	// Отклонение баннера
+	// (POST /banner/{id}/reject)
+	PostBannerIdReject(ctx echo.Context, id int, params PostBannerIdRejectParams) error
+	// Восстановление удалённого баннера
+	// (POST /banner/{id}/restore)
+	PostBannerIdRestore(ctx echo.Context, id int, params PostBannerIdRestoreParams) error
+	// Отправка баннера на ревью
+	// (POST /banner/{id}/submit)
+	PostBannerIdSubmit(ctx echo.Context, id int, params PostBannerIdSubmitParams) error
+	// Получение списка фич
+	// (GET /features)
+	GetFeatures(ctx echo.Context, params GetFeaturesParams) error
+	// Создание фичи
+	// (POST /features)
+	PostFeatures(ctx echo.Context, params PostFeaturesParams) error
+	// Удаление фичи
+	// (DELETE /features/{id})
+	DeleteFeaturesId(ctx echo.Context, id int, params DeleteFeaturesIdParams) error
+	// Получение фичи по идентификатору
+	// (GET /features/{id})
+	GetFeaturesId(ctx echo.Context, id int, params GetFeaturesIdParams) error
+	// Обновление фичи
+	// (PATCH /features/{id})
+	PatchFeaturesId(ctx echo.Context, id int, params PatchFeaturesIdParams) error
+	// Удаление JSON Schema фичи, содержимое её баннеров больше не проверяется
+	// (DELETE /features/{id}/schema)
+	DeleteFeaturesIdSchema(ctx echo.Context, id int, params DeleteFeaturesIdSchemaParams) error
+	// Получение JSON Schema содержимого баннеров фичи
+	// (GET /features/{id}/schema)
+	GetFeaturesIdSchema(ctx echo.Context, id int, params GetFeaturesIdSchemaParams) error
+	// Установка JSON Schema содержимого баннеров фичи
+	// (PUT /features/{id}/schema)
+	PutFeaturesIdSchema(ctx echo.Context, id int, params PutFeaturesIdSchemaParams) error
+	// Получение состояния фоновой задачи
+	// (GET /jobs/{id})
+	GetJobsId(ctx echo.Context, id int, params GetJobsIdParams) error
+	// Проверка готовности сервиса
+	// (GET /readyz)
+	GetReadyz(ctx echo.Context) error
+	// Получение списка тегов
+	// (GET /tags)
+	GetTags(ctx echo.Context, params GetTagsParams) error
+	// Создание тега
+	// (POST /tags)
+	PostTags(ctx echo.Context, params PostTagsParams) error
+	// Удаление тега
+	// (DELETE /tags/{id})
+	DeleteTagsId(ctx echo.Context, id int, params DeleteTagsIdParams) error
+	// Получение тега по идентификатору
+	// (GET /tags/{id})
+	GetTagsId(ctx echo.Context, id int, params GetTagsIdParams) error
+	// Обновление тега
+	// (PATCH /tags/{id})
+	PatchTagsId(ctx echo.Context, id int, params PatchTagsIdParams) error
+	// Получение баннера для пользователя
+	// (GET /user_banner)
+	GetUserBanner(ctx echo.Context, params GetUserBannerParams) error
+	// Получение нескольких баннеров для пользователя
+	// (POST /user_banner/batch)
+	PostUserBannerBatch(ctx echo.Context, params PostUserBannerBatchParams) error
+	// Подписка на изменения баннера пользователя
+	// (GET /user_banner/stream)
+	GetUserBannerStream(ctx echo.Context, params GetUserBannerStreamParams) error
+	// Получение подписок на изменения баннеров
+	// (GET /webhooks)
+	GetWebhooks(ctx echo.Context, params GetWebhooksParams) error
+	// Создание подписки на изменения баннеров
+	// (POST /webhooks)
+	PostWebhooks(ctx echo.Context, params PostWebhooksParams) error
+	// Удаление подписки
+	// (DELETE /webhooks/{id})
+	DeleteWebhooksId(ctx echo.Context, id int, params DeleteWebhooksIdParams) error
+	// Получение подписки
+	// (GET /webhooks/{id})
+	GetWebhooksId(ctx echo.Context, id int, params GetWebhooksIdParams) error
+	// Изменение подписки
+	// (PATCH /webhooks/{id})
+	PatchWebhooksId(ctx echo.Context, id int, params PatchWebhooksIdParams) error
+	// Получение недоставленных событий подписки
+	// (GET /webhooks/{id}/dead-letters)
+	GetWebhooksIdDeadLetters(ctx echo.Context, id int, params GetWebhooksIdDeadLettersParams) error
+	// Повторная отправка недоставленного события
+	// (POST /webhooks/{id}/deliveries/{delivery_id}/redeliver)
+	PostWebhooksIdDeliveriesDeliveryIdRedeliver(ctx echo.Context, id int, deliveryId int, params PostWebhooksIdDeliveriesDeliveryIdRedeliverParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAudit(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", ctx.QueryParams(), &params.Actor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor: %s", err))
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "banner_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "banner_id", ctx.QueryParams(), &params.BannerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter banner_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAudit(ctx, params)
+	return err
+}
+
+// DeleteBanner converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBanner(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBannerParams
+	// ------------- Optional query parameter "feature_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "feature_id", ctx.QueryParams(), &params.FeatureId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter feature_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_id", ctx.QueryParams(), &params.TagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBanner(ctx, params)
+	return err
+}
+
+// GetBanner converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBanner(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBannerParams
+	// ------------- Optional query parameter "feature_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "feature_id", ctx.QueryParams(), &params.FeatureId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter feature_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_id", ctx.QueryParams(), &params.TagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_match", ctx.QueryParams(), &params.TagMatch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_match: %s", err))
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", ctx.QueryParams(), &params.IsActive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_active: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "updated_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_from", ctx.QueryParams(), &params.UpdatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter updated_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "updated_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_to", ctx.QueryParams(), &params.UpdatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter updated_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "content_path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "content_path", ctx.QueryParams(), &params.ContentPath)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter content_path: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "embed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "embed", ctx.QueryParams(), &params.Embed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter embed: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBanner(ctx, params)
+	return err
+}
+
+// PostBanner converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBanner(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBanner(ctx, params)
+	return err
+}
+
+// PostBannerBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBannerBulk(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerBulkParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBannerBulk(ctx, params)
+	return err
+}
+
+// GetBannerConflicts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBannerConflicts(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBannerConflictsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBannerConflicts(ctx, params)
+	return err
+}
+
+// GetBannerExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBannerExport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBannerExportParams
+	// ------------- Optional query parameter "feature_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "feature_id", ctx.QueryParams(), &params.FeatureId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter feature_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_id", ctx.QueryParams(), &params.TagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_match", ctx.QueryParams(), &params.TagMatch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_match: %s", err))
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", ctx.QueryParams(), &params.IsActive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_active: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "updated_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_from", ctx.QueryParams(), &params.UpdatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter updated_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "updated_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_to", ctx.QueryParams(), &params.UpdatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter updated_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "content_path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "content_path", ctx.QueryParams(), &params.ContentPath)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter content_path: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBannerExport(ctx, params)
+	return err
+}
+
+// PostBannerImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBannerImport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerImportParams
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", ctx.QueryParams(), &params.Mode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBannerImport(ctx, params)
+	return err
+}
+
+// GetBannerTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBannerTrash(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBannerTrashParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBannerTrash(ctx, params)
+	return err
+}
+
+// DeleteBannerId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBannerId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBannerIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBannerId(ctx, id, params)
+	return err
+}
+
+// GetBannerId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBannerId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBannerIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBannerId(ctx, id, params)
+	return err
+}
+
+// PatchBannerId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchBannerId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchBannerIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchBannerId(ctx, id, params)
+	return err
+}
+
+// PostBannerIdApprove converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBannerIdApprove(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerIdApproveParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBannerIdApprove(ctx, id, params)
+	return err
+}
+
+// PostBannerIdArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBannerIdArchive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerIdArchiveParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBannerIdArchive(ctx, id, params)
+	return err
+}
+
+// PostBannerIdPublish converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBannerIdPublish(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerIdPublishParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBannerIdPublish(ctx, id, params)
+	return err
+}
+
+// PostBannerIdReject converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBannerIdReject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerIdRejectParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBannerIdReject(ctx, id, params)
+	return err
+}
+
+// PostBannerIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBannerIdRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerIdRestoreParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBannerIdRestore(ctx, id, params)
+	return err
+}
+
+// PostBannerIdSubmit converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBannerIdSubmit(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBannerIdSubmitParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBannerIdSubmit(ctx, id, params)
+	return err
+}
+
+// GetFeatures converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFeatures(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFeaturesParams
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", ctx.QueryParams(), &params.Archived)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter archived: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFeatures(ctx, params)
+	return err
+}
+
+// PostFeatures converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFeatures(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFeaturesParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFeatures(ctx, params)
+	return err
+}
+
+// DeleteFeaturesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFeaturesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFeaturesIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFeaturesId(ctx, id, params)
+	return err
+}
+
+// GetFeaturesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFeaturesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFeaturesIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFeaturesId(ctx, id, params)
+	return err
+}
+
+// PatchFeaturesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFeaturesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchFeaturesIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchFeaturesId(ctx, id, params)
+	return err
+}
+
+// DeleteFeaturesIdSchema converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFeaturesIdSchema(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFeaturesIdSchemaParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFeaturesIdSchema(ctx, id, params)
+	return err
+}
+
+// GetFeaturesIdSchema converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFeaturesIdSchema(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFeaturesIdSchemaParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFeaturesIdSchema(ctx, id, params)
+	return err
+}
+
+// PutFeaturesIdSchema converts echo context to params.
+func (w *ServerInterfaceWrapper) PutFeaturesIdSchema(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutFeaturesIdSchemaParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutFeaturesIdSchema(ctx, id, params)
+	return err
+}
+
+// GetJobsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJobsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJobsId(ctx, id, params)
+	return err
+}
+
+// GetReadyz converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReadyz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReadyz(ctx)
+	return err
+}
+
+// GetTags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTags(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagsParams
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", ctx.QueryParams(), &params.Archived)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter archived: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTags(ctx, params)
+	return err
+}
+
+// PostTags converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTags(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTagsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTags(ctx, params)
+	return err
+}
+
+// DeleteTagsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTagsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTagsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTagsId(ctx, id, params)
+	return err
+}
+
+// GetTagsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTagsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTagsId(ctx, id, params)
+	return err
+}
+
+// PatchTagsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTagsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTagsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTagsId(ctx, id, params)
+	return err
+}
+
+// GetUserBanner converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserBanner(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserBannerParams
+	// ------------- Required query parameter "tag_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "tag_id", ctx.QueryParams(), &params.TagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// ------------- Required query parameter "feature_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "feature_id", ctx.QueryParams(), &params.FeatureId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter feature_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "use_last_revision" -------------
 
-// PostBannerParams defines parameters for PostBanner.
-type PostBannerParams struct {
-	// Token Токен админа
-	Token *string `json:"token,omitempty"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "use_last_revision", ctx.QueryParams(), &params.UseLastRevision)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter use_last_revision: %s", err))
+	}
 
-// DeleteBannerIdParams defines parameters for DeleteBannerId.
-type DeleteBannerIdParams struct {
-	// Token Токен админа
-	Token *string `json:"token,omitempty"`
-}
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
 
-// PatchBannerIdParams defines parameters for PatchBannerId.
-type PatchBannerIdParams struct {
-	// Token Токен админа
-	Token *string `json:"token,omitempty"`
-}
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
 
-// GetUserBannerParams defines parameters for GetUserBanner.
-type GetUserBannerParams struct {
-	TagId           int   `form:"tag_id" json:"tag_id"`
-	FeatureId       int   `form:"feature_id" json:"feature_id"`
-	UseLastRevision *bool `form:"use_last_revision,omitempty" json:"use_last_revision,omitempty"`
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
 
-	// Token Токен пользователя
-	Token *string `json:"token,omitempty"`
-}
+		params.IfNoneMatch = &IfNoneMatch
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получение всех баннеров c фильтрацией по фиче и/или тегу
-	// (GET /banner)
-	GetBanner(ctx echo.Context, params GetBannerParams) error
-	// Создание нового баннера
-	// (POST /banner)
-	PostBanner(ctx echo.Context, params PostBannerParams) error
-	// Удаление баннера по идентификатору
-	// (DELETE /banner/{id})
-	DeleteBannerId(ctx echo.Context, id int, params DeleteBannerIdParams) error
-	// Обновление содержимого баннера
-	// (PATCH /banner/{id})
-	PatchBannerId(ctx echo.Context, id int, params PatchBannerIdParams) error
-	// Получение баннера для пользователя
-	// (GET /user_banner)
-	GetUserBanner(ctx echo.Context, params GetUserBannerParams) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserBanner(ctx, params)
+	return err
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// PostUserBannerBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUserBannerBatch(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUserBannerBatchParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUserBannerBatch(ctx, params)
+	return err
 }
 
-// GetBanner converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBanner(ctx echo.Context) error {
+// GetUserBannerStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserBannerStream(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBannerParams
-	// ------------- Optional query parameter "feature_id" -------------
+	var params GetUserBannerStreamParams
+	// ------------- Required query parameter "tag_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "feature_id", ctx.QueryParams(), &params.FeatureId)
+	err = runtime.BindQueryParameter("form", true, true, "tag_id", ctx.QueryParams(), &params.TagId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter feature_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "tag_id" -------------
+	// ------------- Required query parameter "feature_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "tag_id", ctx.QueryParams(), &params.TagId)
+	err = runtime.BindQueryParameter("form", true, true, "feature_id", ctx.QueryParams(), &params.FeatureId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter feature_id: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserBannerStream(ctx, params)
+	return err
+}
+
+// GetWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooks(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksParams
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
@@ -116,7 +2888,6 @@ func (w *ServerInterfaceWrapper) GetBanner(ctx echo.Context) error {
 		if n != 1 {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
 		}
-
 		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
@@ -126,17 +2897,18 @@ func (w *ServerInterfaceWrapper) GetBanner(ctx echo.Context) error {
 	} else {
 		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
 	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBanner(ctx, params)
+	err = w.Handler.GetWebhooks(ctx, params)
 	return err
 }
 
-// PostBanner converts echo context to params.
-func (w *ServerInterfaceWrapper) PostBanner(ctx echo.Context) error {
+// PostWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooks(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostBannerParams
+	var params PostWebhooksParams
 
 	headers := ctx.Request().Header
 	// ------------- Optional header parameter "token" -------------
@@ -146,7 +2918,6 @@ func (w *ServerInterfaceWrapper) PostBanner(ctx echo.Context) error {
 		if n != 1 {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
 		}
-
 		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
@@ -158,12 +2929,12 @@ func (w *ServerInterfaceWrapper) PostBanner(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostBanner(ctx, params)
+	err = w.Handler.PostWebhooks(ctx, params)
 	return err
 }
 
-// DeleteBannerId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteBannerId(ctx echo.Context) error {
+// DeleteWebhooksId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhooksId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -174,7 +2945,7 @@ func (w *ServerInterfaceWrapper) DeleteBannerId(ctx echo.Context) error {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteBannerIdParams
+	var params DeleteWebhooksIdParams
 
 	headers := ctx.Request().Header
 	// ------------- Optional header parameter "token" -------------
@@ -184,7 +2955,43 @@ func (w *ServerInterfaceWrapper) DeleteBannerId(ctx echo.Context) error {
 		if n != 1 {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
 		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
+
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWebhooksId(ctx, id, params)
+	return err
+}
+
+// GetWebhooksId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooksId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
 		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
@@ -196,12 +3003,12 @@ func (w *ServerInterfaceWrapper) DeleteBannerId(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteBannerId(ctx, id, params)
+	err = w.Handler.GetWebhooksId(ctx, id, params)
 	return err
 }
 
-// PatchBannerId converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchBannerId(ctx echo.Context) error {
+// PatchWebhooksId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchWebhooksId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -212,7 +3019,7 @@ func (w *ServerInterfaceWrapper) PatchBannerId(ctx echo.Context) error {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PatchBannerIdParams
+	var params PatchWebhooksIdParams
 
 	headers := ctx.Request().Header
 	// ------------- Optional header parameter "token" -------------
@@ -233,36 +3040,82 @@ func (w *ServerInterfaceWrapper) PatchBannerId(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchBannerId(ctx, id, params)
+	err = w.Handler.PatchWebhooksId(ctx, id, params)
 	return err
 }
 
-// GetUserBanner converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUserBanner(ctx echo.Context) error {
+// GetWebhooksIdDeadLetters converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooksIdDeadLetters(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserBannerParams
-	// ------------- Required query parameter "tag_id" -------------
+	var params GetWebhooksIdDeadLettersParams
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "tag_id", ctx.QueryParams(), &params.TagId)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "feature_id" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "feature_id", ctx.QueryParams(), &params.FeatureId)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter feature_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "use_last_revision" -------------
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
+		var Token string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for token, got %d", n))
+		}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationHeader, valueList[0], &Token)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+		}
 
-	err = runtime.BindQueryParameter("form", true, false, "use_last_revision", ctx.QueryParams(), &params.UseLastRevision)
+		params.Token = &Token
+	} else {
+		return echo.NewHTTPError(http.StatusUnauthorized, "No token was provided")
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooksIdDeadLetters(ctx, id, params)
+	return err
+}
+
+// PostWebhooksIdDeliveriesDeliveryIdRedeliver converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksIdDeliveriesDeliveryIdRedeliver(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter use_last_revision: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "delivery_id" -------------
+	var deliveryId int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "delivery_id", runtime.ParamLocationPath, ctx.Param("delivery_id"), &deliveryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter delivery_id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhooksIdDeliveriesDeliveryIdRedeliverParams
+
 	headers := ctx.Request().Header
 	// ------------- Optional header parameter "token" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("token")]; found {
@@ -282,7 +3135,7 @@ func (w *ServerInterfaceWrapper) GetUserBanner(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUserBanner(ctx, params)
+	err = w.Handler.PostWebhooksIdDeliveriesDeliveryIdRedeliver(ctx, id, deliveryId, params)
 	return err
 }
 
@@ -307,9 +3160,47 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
+	router.GET("/audit", wrapper.GetAudit)
+	router.DELETE("/banner", wrapper.DeleteBanner)
 	router.GET("/banner", wrapper.GetBanner)
 	router.POST("/banner", wrapper.PostBanner)
+	router.POST("/banner/bulk", wrapper.PostBannerBulk)
+	router.GET("/banner/conflicts", wrapper.GetBannerConflicts)
+	router.GET("/banner/export", wrapper.GetBannerExport)
+	router.POST("/banner/import", wrapper.PostBannerImport)
+	router.GET("/banner/trash", wrapper.GetBannerTrash)
 	router.DELETE("/banner/:id", wrapper.DeleteBannerId)
+	router.GET("/banner/:id", wrapper.GetBannerId)
 	router.PATCH("/banner/:id", wrapper.PatchBannerId)
+	router.POST("/banner/:id/approve", wrapper.PostBannerIdApprove)
+	router.POST("/banner/:id/archive", wrapper.PostBannerIdArchive)
+	router.POST("/banner/:id/publish", wrapper.PostBannerIdPublish)
+	router.POST("/banner/:id/reject", wrapper.PostBannerIdReject)
+	router.POST("/banner/:id/restore", wrapper.PostBannerIdRestore)
+	router.POST("/banner/:id/submit", wrapper.PostBannerIdSubmit)
+	router.GET("/features", wrapper.GetFeatures)
+	router.POST("/features", wrapper.PostFeatures)
+	router.DELETE("/features/:id", wrapper.DeleteFeaturesId)
+	router.GET("/features/:id", wrapper.GetFeaturesId)
+	router.PATCH("/features/:id", wrapper.PatchFeaturesId)
+	router.DELETE("/features/:id/schema", wrapper.DeleteFeaturesIdSchema)
+	router.GET("/features/:id/schema", wrapper.GetFeaturesIdSchema)
+	router.PUT("/features/:id/schema", wrapper.PutFeaturesIdSchema)
+	router.GET("/jobs/:id", wrapper.GetJobsId)
+	router.GET("/readyz", wrapper.GetReadyz)
+	router.GET("/tags", wrapper.GetTags)
+	router.POST("/tags", wrapper.PostTags)
+	router.DELETE("/tags/:id", wrapper.DeleteTagsId)
+	router.GET("/tags/:id", wrapper.GetTagsId)
+	router.PATCH("/tags/:id", wrapper.PatchTagsId)
 	router.GET("/user_banner", wrapper.GetUserBanner)
+	router.POST("/user_banner/batch", wrapper.PostUserBannerBatch)
+	router.GET("/user_banner/stream", wrapper.GetUserBannerStream)
+	router.GET("/webhooks", wrapper.GetWebhooks)
+	router.POST("/webhooks", wrapper.PostWebhooks)
+	router.DELETE("/webhooks/:id", wrapper.DeleteWebhooksId)
+	router.GET("/webhooks/:id", wrapper.GetWebhooksId)
+	router.PATCH("/webhooks/:id", wrapper.PatchWebhooksId)
+	router.GET("/webhooks/:id/dead-letters", wrapper.GetWebhooksIdDeadLetters)
+	router.POST("/webhooks/:id/deliveries/:delivery_id/redeliver", wrapper.PostWebhooksIdDeliveriesDeliveryIdRedeliver)
 }
@@ -7,12 +7,12 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-redis/redismock/v8"
 	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
-	"time"
 )
 
 func TestUserBannerCacheGet(t *testing.T) {
@@ -34,8 +34,7 @@ func TestUserBannerCacheGet(t *testing.T) {
 	}
 
 	cache, cache_mock := redismock.NewClientMock()
-	cache_mock.ExpectGet("2:3").SetVal(string(jsonData))
-	cache_mock.ExpectGet("2:3:isactive").SetVal("true")
+	expectCachedUserBanner(cache_mock, "2:3", jsonData, true, 3)
 	server := &Server{
 		tokens: map[string]string{
 			"IGOTTHEPOWER!": "admin",
@@ -96,8 +95,7 @@ func TestUserBannerGetCacheForbidden(t *testing.T) {
 	}
 	
 	cache, cache_mock := redismock.NewClientMock()
-	cache_mock.ExpectGet("2:3").SetVal(string(jsonData))
-	cache_mock.ExpectGet("2:3:isactive").SetVal("false")
+	expectCachedUserBanner(cache_mock, "2:3", jsonData, false, 3)
 	server := &Server{
 		tokens: map[string]string{
 			"IGOTTHEPOWER!": "admin",
@@ -142,12 +140,11 @@ func TestUserBannerGetDB(t *testing.T) {
 	}
 	
 	cache, cache_mock := redismock.NewClientMock()
-	cache_mock.ExpectSet("2:3", jsonData, 5*time.Minute).SetVal("OK")
-	cache_mock.ExpectSet("2:3:isactive", true, 5*time.Minute).SetVal("OK")
-	rows := sqlmock.NewRows([]string{"content", "is_active"}).
-		AddRow(jsonData, true)
-	db_mock.ExpectQuery("SELECT content, is_active FROM banners WHERE feature_id = ($1) AND ($2) = ANY(tag_ids)").
-		WithArgs(2, 3).
+	expectCacheUserBanner(cache_mock, "2:3", jsonData, true, 3)
+	rows := sqlmock.NewRows([]string{"content", "is_active", "tag"}).
+		AddRow(jsonData, true, 3)
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{3})).
 		WillReturnRows(rows)
 
 	server := &Server{
@@ -215,10 +212,10 @@ func TestUserBannerGetDBForbidden(t *testing.T) {
 	}
 	
 	cache, _ := redismock.NewClientMock()
-	rows := sqlmock.NewRows([]string{"content", "is_active"}).
-		AddRow(jsonData, false)
-	db_mock.ExpectQuery("SELECT content, is_active FROM banners WHERE feature_id = ($1) AND ($2) = ANY(tag_ids)").
-		WithArgs(2, 3).
+	rows := sqlmock.NewRows([]string{"content", "is_active", "tag"}).
+		AddRow(jsonData, false, 3)
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{3})).
 		WillReturnRows(rows)
 	server := &Server{
 		tokens: map[string]string{
@@ -255,8 +252,8 @@ func TestUserBannerGetDBNotFound(t *testing.T) {
 	defer db.Close()
 	
 	cache, _ := redismock.NewClientMock()
-	db_mock.ExpectQuery("SELECT content, is_active FROM banners WHERE feature_id = ($1) AND ($2) = ANY(tag_ids)").
-		WithArgs(2, 3).
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{3})).
 		WillReturnError(errors.New("Not found!"))
 	server := &Server{
 		tokens: map[string]string{
@@ -388,7 +385,7 @@ func TestUserBannerGetBadReq(t *testing.T) {
 
 	httpError = err.(*echo.HTTPError)
 	assert.Equal(t, http.StatusBadRequest, httpError.Code)
-	assert.Equal(t, "code=400, message=Invalid format for parameter tag_id: error binding string parameter: strconv.ParseInt: parsing \"true\": invalid syntax", err.Error())
+	assert.Equal(t, "code=400, message=Invalid format for parameter tag_id: error setting array element: error binding string parameter: strconv.ParseInt: parsing \"true\": invalid syntax", err.Error())
 
 	req = httptest.NewRequest(http.MethodGet, "/user_banner?tag_id=3&feature_id=true", nil)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
@@ -411,3 +408,53 @@ func TestUserBannerGetBadReq(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, httpError.Code)
 	assert.Equal(t, "code=400, message=Invalid format for parameter token: parameter 'token' is empty, can't bind its value", err.Error())
 }
+
+func TestUserBannerGetMultipleTags(t *testing.T) {
+	db, db_mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	jsonData := []byte(`{"key": "value"}`)
+	cache, cache_mock := redismock.NewClientMock()
+	expectUserBannerCacheMiss(cache_mock, "2:5,3")
+	expectCacheUserBanner(cache_mock, "2:5,3", jsonData, true, 3)
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{5, 3})).
+		WillReturnRows(sqlmock.NewRows([]string{"content", "is_active", "tag"}).AddRow(jsonData, true, 3))
+	server := &Server{
+		tokens: map[string]string{
+			"IGOTTHEPOWER!": "admin",
+			"IMACREEP":      "user",
+		},
+		db:    db,
+		cache: cache,
+		ctx:   context.Background(),
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/user_banner?tag_id=5&tag_id=3&tag_id=5&feature_id=2", nil)
+	req.Header.Set("token", "IMACREEP")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetUserBanner(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "3", rec.Header().Get("X-Matched-Tag-Id"))
+		assert.JSONEq(t, string(jsonData), rec.Body.String())
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,611 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Redych-the-kid/go_projects/golang_banner/bannerpb"
+	"github.com/labstack/gommon/random"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// gRPC codes of statuses banner functions reject requests with, other statuses are Internal
+var grpcStatusCodes = map[int]codes.Code{
+	http.StatusBadRequest:           codes.InvalidArgument,
+	http.StatusUnsupportedMediaType: codes.InvalidArgument,
+	http.StatusUnauthorized:         codes.Unauthenticated,
+	http.StatusForbidden:            codes.PermissionDenied,
+	http.StatusNotFound:             codes.NotFound,
+	http.StatusConflict:             codes.FailedPrecondition,
+	http.StatusPreconditionFailed:   codes.FailedPrecondition,
+	http.StatusPreconditionRequired: codes.FailedPrecondition,
+	http.StatusUnprocessableEntity:  codes.FailedPrecondition,
+}
+
+// BannerService implementation. Methods call the functions REST handlers are built on,
+// so tokens, validation, audit and cache are the same as in REST API
+type grpcServer struct {
+	bannerpb.UnimplementedBannerServiceServer
+	server *Server
+}
+
+func newGRPCServer(server *Server) *grpc.Server {
+	g := grpc.NewServer(grpc.UnaryInterceptor(grpcRequestID), grpc.StreamInterceptor(grpcStreamRequestID))
+	bannerpb.RegisterBannerServiceServer(g, &grpcServer{server: server})
+	return g
+}
+
+// Gives request without x-request-id a generated one, like REST API does, and returns it in response header
+func grpcRequestID(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, requestID := withRequestID(ctx)
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-request-id", requestID)); err != nil {
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+	logGRPCError(info.FullMethod, requestID, err)
+	return resp, err
+}
+
+// Same as grpcRequestID for streaming methods
+func grpcStreamRequestID(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, requestID := withRequestID(stream.Context())
+
+	if err := stream.SetHeader(metadata.Pairs("x-request-id", requestID)); err != nil {
+		return err
+	}
+
+	err := handler(srv, &requestIDStream{ServerStream: stream, ctx: ctx})
+	logGRPCError(info.FullMethod, requestID, err)
+	return err
+}
+
+// Server stream with context that carries request ID
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStream) Context() context.Context {
+	return s.ctx
+}
+
+// Returns incoming context with x-request-id, generated if the client didn't send one
+func withRequestID(ctx context.Context) (context.Context, string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+
+	if len(md.Get("x-request-id")) == 0 {
+		md.Set("x-request-id", random.String(32))
+	}
+	return metadata.NewIncomingContext(ctx, md), md.Get("x-request-id")[0]
+}
+
+// Logs calls that failed on the server side. Rejected requests are the client's problem and are not logged
+func logGRPCError(method string, requestID string, err error) {
+	if code := status.Code(err); code == codes.Internal || code == codes.Unknown {
+		log.Printf("gRPC %s failed, request %s: %s", method, requestID, err)
+	}
+}
+
+// First value of metadata key, empty if there is none
+func metadataValue(ctx context.Context, key string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Checks token of request. Returns the token and, for admins only methods, rejects user tokens
+func (g *grpcServer) authorize(ctx context.Context, admin bool) (string, error) {
+	token := metadataValue(ctx, "token")
+
+	if !validateToken(token, g.server.tokens) {
+		return "", status.Error(codes.Unauthenticated, "Пользователь не авторизован")
+	}
+	if admin && !validateAdminToken(token, g.server.tokens) {
+		return "", status.Error(codes.PermissionDenied, "Пользователь не имеет доступа")
+	}
+	return token, nil
+}
+
+// Builds audit source of request. Audit log records address of gRPC client
+func (g *grpcServer) auditSource(ctx context.Context, token string) auditSource {
+	sourceIP := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		sourceIP = p.Addr.String()
+
+		if host, _, err := net.SplitHostPort(sourceIP); err == nil {
+			sourceIP = host
+		}
+	}
+	return g.server.newAuditSource(token, metadataValue(ctx, "x-request-id"), sourceIP)
+}
+
+// Converts rejected write or failed call to gRPC status
+func grpcResult(writeErr *bannerWriteError, err error) error {
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if writeErr != nil {
+		return grpcError(writeErr.status, writeErr.message, writeErr.fields)
+	}
+	return nil
+}
+
+// Builds gRPC status of HTTP status. Field errors are attached as BadRequest
+func grpcError(httpStatus int, message string, fields []FieldError) error {
+	code, ok := grpcStatusCodes[httpStatus]
+	if !ok {
+		code = codes.Internal
+	}
+	st := status.New(code, message)
+
+	if fields != nil {
+		badRequest := &errdetails.BadRequest{}
+		for _, field := range fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field.Field,
+				Description: field.Message,
+			})
+		}
+		if withDetails, err := st.WithDetails(badRequest); err == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
+
+// Rejects write without if_match when server requires it
+func (g *grpcServer) checkIfMatch(ifMatch *string) error {
+	if g.server.requireIfMatch && ifMatch == nil {
+		return grpcError(http.StatusPreconditionRequired, "Требуется if_match", nil)
+	}
+	return nil
+}
+
+func intIDs(ids []int64) []int {
+	result := make([]int, len(ids))
+	for i, id := range ids {
+		result[i] = int(id)
+	}
+	return result
+}
+
+// Decodes JSON object, like banner content, into message
+func structFromJSON(data []byte) (*structpb.Struct, error) {
+	var value structpb.Struct
+
+	if err := protojson.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+func timestampOrNil(value *timestamppb.Timestamp) *time.Time {
+	if value == nil {
+		return nil
+	}
+	t := value.AsTime()
+	return &t
+}
+
+func timestampMessage(value *time.Time) *timestamppb.Timestamp {
+	if value == nil {
+		return nil
+	}
+	return timestamppb.New(*value)
+}
+
+func bannerMessage(banner Banner) (*bannerpb.Banner, error) {
+	content, err := structFromJSON(banner.Content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	message := &bannerpb.Banner{
+		Id:                banner.ID,
+		TagIds:            banner.TagIDs,
+		FeatureId:         int64(banner.FeatureID),
+		Content:           content,
+		IsActive:          banner.IsActive,
+		CreatedAt:         timestamppb.New(banner.CreatedAt),
+		UpdatedAt:         timestamppb.New(banner.UpdatedAt),
+		Revision:          banner.Revision,
+		Priority:          int32(banner.Priority),
+		State:             banner.State,
+		PublishedRevision: banner.PublishedRevision,
+		ReviewComment:     banner.ReviewComment,
+		Etag:              bannerETag(banner.Revision),
+	}
+	message.PublishedAt = timestampMessage(banner.PublishedAt)
+	message.DeletedAt = timestampMessage(banner.DeletedAt)
+	if banner.Feature != nil {
+		message.Feature = &bannerpb.EntityRef{Id: int64(banner.Feature.Id), Name: banner.Feature.Name}
+	}
+	for _, tag := range banner.Tags {
+		message.Tags = append(message.Tags, &bannerpb.EntityRef{Id: int64(tag.Id), Name: tag.Name})
+	}
+	return message, nil
+}
+
+// Converts banner fields of create request, they are checked by validateBannerInput afterwards
+func bannerInput(message *bannerpb.BannerInput) BannerInput {
+	input := BannerInput{
+		TagIds:    intIDs(message.TagIds),
+		FeatureId: int(message.FeatureId),
+		IsActive:  message.IsActive,
+	}
+	if message.Content != nil {
+		input.Content = message.Content.AsMap()
+	}
+	if message.Priority != nil {
+		priority := int(*message.Priority)
+		input.Priority = &priority
+	}
+	return input
+}
+
+func bannerRevisionMessage(revision int64) *bannerpb.BannerRevision {
+	return &bannerpb.BannerRevision{Revision: revision, Etag: bannerETag(revision)}
+}
+
+// Query parameters of GET /banner that list request sets
+func bannerListParams(req *bannerpb.ListBannersRequest) GetBannerParams {
+	var params GetBannerParams
+
+	if len(req.FeatureId) > 0 {
+		featureIDs := intIDs(req.FeatureId)
+		params.FeatureId = &featureIDs
+	}
+	if len(req.TagId) > 0 {
+		tagIDs := intIDs(req.TagId)
+		params.TagId = &tagIDs
+	}
+	if req.TagMatch != nil {
+		tagMatch := GetBannerParamsTagMatch(*req.TagMatch)
+		params.TagMatch = &tagMatch
+	}
+	params.IsActive = req.IsActive
+	params.CreatedFrom = timestampOrNil(req.CreatedFrom)
+	params.CreatedTo = timestampOrNil(req.CreatedTo)
+	params.UpdatedFrom = timestampOrNil(req.UpdatedFrom)
+	params.UpdatedTo = timestampOrNil(req.UpdatedTo)
+	params.Q = req.Q
+	params.ContentPath = req.ContentPath
+	if req.Limit != nil {
+		limit := int(*req.Limit)
+		params.Limit = &limit
+	}
+	if req.Offset != nil {
+		offset := int(*req.Offset)
+		params.Offset = &offset
+	}
+	if req.Sort != nil {
+		sort := GetBannerParamsSort(*req.Sort)
+		params.Sort = &sort
+	}
+	if req.Order != nil {
+		order := GetBannerParamsOrder(*req.Order)
+		params.Order = &order
+	}
+	params.Cursor = req.Cursor
+	if req.State != nil {
+		state := GetBannerParamsState(*req.State)
+		params.State = &state
+	}
+	if req.EmbedNames {
+		embed := Names
+		params.Embed = &embed
+	}
+	return params
+}
+
+func (g *grpcServer) GetUserBanner(ctx context.Context, req *bannerpb.GetUserBannerRequest) (*bannerpb.UserBanner, error) {
+	token, err := g.authorize(ctx, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	banner, writeErr, err := g.server.lookupUserBanner(token, int(req.FeatureId), intIDs(req.TagId), req.UseLastRevision, nil)
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	content, err := structFromJSON(banner.content)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &bannerpb.UserBanner{Content: content, MatchedTagId: int64(banner.matchedTag), Etag: banner.etag}, nil
+}
+
+func (g *grpcServer) GetUserBannerBatch(ctx context.Context, req *bannerpb.UserBannerBatchRequest) (*bannerpb.UserBannerBatchResponse, error) {
+	token, err := g.authorize(ctx, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]UserBannerBatchItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = UserBannerBatchItem{FeatureId: int(item.FeatureId), TagId: int(item.TagId)}
+	}
+	if fieldErrs := validateUserBannerBatch(items); len(fieldErrs) > 0 {
+		return nil, grpcResult(invalidBannerError(fieldErrs), nil)
+	}
+
+	results, err := g.server.lookupUserBanners(token, items, req.UseLastRevision)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	message := &bannerpb.UserBannerBatchResponse{}
+	for _, result := range results {
+		item := &bannerpb.UserBannerBatchResult{
+			FeatureId: int64(result.FeatureId),
+			TagId:     int64(result.TagId),
+			Status:    int32(result.Status),
+			Error:     result.Error,
+		}
+		if result.Content != nil {
+			if item.Content, err = structFromJSON(*result.Content); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+		message.Items = append(message.Items, item)
+	}
+	return message, nil
+}
+
+// Same stream as GET /user_banner/stream, without SSE framing. HTTP/2 keeps connection alive, so there are no keep-alive events
+func (g *grpcServer) WatchUserBanner(req *bannerpb.WatchUserBannerRequest, stream bannerpb.BannerService_WatchUserBannerServer) error {
+	token := metadataValue(stream.Context(), "token")
+	tagIDs, writeErr := g.server.checkUserBannerStream(token, intIDs(req.TagId))
+
+	if writeErr != nil {
+		return grpcError(writeErr.status, writeErr.message, writeErr.fields)
+	}
+
+	send := func(event streamEvent) error {
+		message, err := userBannerEventMessage(event)
+
+		if err != nil {
+			return err
+		}
+		return stream.Send(message)
+	}
+	err := g.server.watchUserBanner(stream.Context(), token, int(req.FeatureId), tagIDs, req.LastEventId, send, nil)
+
+	if err != nil && stream.Context().Err() == nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func userBannerEventMessage(event streamEvent) (*bannerpb.UserBannerEvent, error) {
+	message := &bannerpb.UserBannerEvent{Id: event.id}
+	if event.name == "unavailable" {
+		var unavailable struct {
+			Status int32 `json:"status"`
+		}
+		if err := json.Unmarshal(event.data, &unavailable); err != nil {
+			return nil, err
+		}
+		message.Event = &bannerpb.UserBannerEvent_UnavailableStatus{UnavailableStatus: unavailable.Status}
+		return message, nil
+	}
+
+	content, err := structFromJSON(event.data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	banner := &bannerpb.UserBanner{Content: content, MatchedTagId: int64(event.matchedTag), Etag: contentETag(event.data)}
+	message.Event = &bannerpb.UserBannerEvent_Banner{Banner: banner}
+	return message, nil
+}
+
+func (g *grpcServer) ListBanners(ctx context.Context, req *bannerpb.ListBannersRequest) (*bannerpb.BannerPage, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	page, writeErr, err := g.server.listBanners(bannerListParams(req))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message := &bannerpb.BannerPage{NextCursor: page.nextCursor, TotalCount: int64(page.total)}
+	for _, banner := range page.banners {
+		item, err := bannerMessage(banner)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		message.Banners = append(message.Banners, item)
+	}
+	return message, nil
+}
+
+func (g *grpcServer) GetBanner(ctx context.Context, req *bannerpb.GetBannerRequest) (*bannerpb.Banner, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	banner, writeErr, err := g.server.findBanner(int(req.Id))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message, err := bannerMessage(banner)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return message, nil
+}
+
+func (g *grpcServer) CreateBanner(ctx context.Context, req *bannerpb.CreateBannerRequest) (*bannerpb.CreateBannerResponse, error) {
+	token, err := g.authorize(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+	if req.Banner == nil {
+		return nil, grpcResult(invalidBannerError([]FieldError{{Field: "body", Message: "is required"}}), nil)
+	}
+
+	input := bannerInput(req.Banner)
+	if fieldErrs := validateBannerInput(input); len(fieldErrs) > 0 {
+		return nil, grpcResult(invalidBannerError(fieldErrs), nil)
+	}
+
+	var id int
+	audit := g.auditSource(ctx, token)
+	writeErr, err := g.server.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+		created, writeErr, err := createBanner(tx, input, audit)
+		id = created
+		return writeErr, err
+	})
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return &bannerpb.CreateBannerResponse{BannerId: int64(id)}, nil
+}
+
+func (g *grpcServer) PatchBanner(ctx context.Context, req *bannerpb.PatchBannerRequest) (*bannerpb.BannerRevision, error) {
+	token, err := g.authorize(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+	if err := g.checkIfMatch(req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	data, err := protojson.Marshal(req.Patch)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(data) > maxRequestSize {
+		return nil, grpcResult(invalidBannerError([]FieldError{{Field: "body", Message: fmt.Sprintf("request body exceeds %d bytes", maxRequestSize)}}), nil)
+	}
+
+	var revision int64
+	audit := g.auditSource(ctx, token)
+	writeErr, err := g.server.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+		patched, writeErr, err := patchBanner(tx, int(req.Id), req.IfMatch, req.ContentType, data, audit)
+		revision = patched
+		return writeErr, err
+	})
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return bannerRevisionMessage(revision), nil
+}
+
+func (g *grpcServer) DeleteBanner(ctx context.Context, req *bannerpb.DeleteBannerRequest) (*bannerpb.DeleteBannerResponse, error) {
+	token, err := g.authorize(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+	if err := g.checkIfMatch(req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	if err := grpcResult(g.server.removeBanner(int(req.Id), req.IfMatch, g.auditSource(ctx, token))); err != nil {
+		return nil, err
+	}
+	return &bannerpb.DeleteBannerResponse{}, nil
+}
+
+// Applies transition to banner. Approve and reject take review comment, reject requires it
+func (g *grpcServer) transit(ctx context.Context, req *bannerpb.BannerTransitionRequest, t bannerTransition) (*bannerpb.BannerRevision, error) {
+	token, err := g.authorize(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var comment *string
+	if t.comment {
+		var fieldErrs []FieldError
+		comment, fieldErrs = checkReviewComment(req.Comment, t.action == auditReject)
+
+		if len(fieldErrs) > 0 {
+			return nil, grpcResult(invalidBannerError(fieldErrs), nil)
+		}
+	}
+
+	revision, writeErr, err := g.server.applyTransition(int(req.Id), req.IfMatch, t, comment, g.auditSource(ctx, token))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return bannerRevisionMessage(revision), nil
+}
+
+func (g *grpcServer) SubmitBanner(ctx context.Context, req *bannerpb.BannerTransitionRequest) (*bannerpb.BannerRevision, error) {
+	return g.transit(ctx, req, submitTransition)
+}
+
+func (g *grpcServer) ApproveBanner(ctx context.Context, req *bannerpb.BannerTransitionRequest) (*bannerpb.BannerRevision, error) {
+	return g.transit(ctx, req, approveTransition)
+}
+
+func (g *grpcServer) RejectBanner(ctx context.Context, req *bannerpb.BannerTransitionRequest) (*bannerpb.BannerRevision, error) {
+	return g.transit(ctx, req, rejectTransition)
+}
+
+func (g *grpcServer) PublishBanner(ctx context.Context, req *bannerpb.BannerTransitionRequest) (*bannerpb.BannerRevision, error) {
+	return g.transit(ctx, req, publishTransition)
+}
+
+func (g *grpcServer) ArchiveBanner(ctx context.Context, req *bannerpb.BannerTransitionRequest) (*bannerpb.BannerRevision, error) {
+	return g.transit(ctx, req, archiveTransition)
+}
+
+// Readiness is returned as is, not ready service is not an error of the call
+func (g *grpcServer) GetReadiness(ctx context.Context, _ *emptypb.Empty) (*bannerpb.Readiness, error) {
+	readiness := g.server.readiness(ctx)
+
+	message := &bannerpb.Readiness{
+		Ready:                 readiness.Ready,
+		SchemaVersion:         int64(readiness.SchemaVersion),
+		ExpectedSchemaVersion: int64(readiness.ExpectedSchemaVersion),
+	}
+	if readiness.Errors != nil {
+		message.Errors = *readiness.Errors
+	}
+	return message, nil
+}
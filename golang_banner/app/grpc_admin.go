@@ -0,0 +1,853 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Redych-the-kid/go_projects/golang_banner/bannerpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Admin methods of BannerService besides banner CRUD and workflow: bulk operations, import and export, trash,
+// feature and tag catalogs, webhooks and audit. Like the rest of grpcServer, they call the functions REST handlers are built on
+
+func optionalInt(value *int64) *int {
+	if value == nil {
+		return nil
+	}
+	result := int(*value)
+	return &result
+}
+
+func optionalInt64(value *int) *int64 {
+	if value == nil {
+		return nil
+	}
+	result := int64(*value)
+	return &result
+}
+
+func optionalInt32(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	result := int32(*value)
+	return &result
+}
+
+func fieldErrorMessages(fields *[]FieldError) []*bannerpb.FieldError {
+	if fields == nil {
+		return nil
+	}
+
+	var messages []*bannerpb.FieldError
+	for _, field := range *fields {
+		messages = append(messages, &bannerpb.FieldError{Field: field.Field, Message: field.Message})
+	}
+	return messages
+}
+
+// Converts JSON object decoded from database, like job parameters, into message
+func structFromMap(value *map[string]interface{}) (*structpb.Struct, error) {
+	if value == nil {
+		return nil, nil
+	}
+	return structpb.NewStruct(*value)
+}
+
+func auditChangeMessages(changes map[string]AuditChange) (map[string]*bannerpb.AuditChange, error) {
+	messages := make(map[string]*bannerpb.AuditChange, len(changes))
+	for field, change := range changes {
+		message := &bannerpb.AuditChange{}
+		var err error
+
+		if change.Before != nil {
+			if message.Before, err = structpb.NewValue(*change.Before); err != nil {
+				return nil, err
+			}
+		}
+		if change.After != nil {
+			if message.After, err = structpb.NewValue(*change.After); err != nil {
+				return nil, err
+			}
+		}
+		messages[field] = message
+	}
+	return messages, nil
+}
+
+func (g *grpcServer) ListBannerConflicts(ctx context.Context, _ *emptypb.Empty) (*bannerpb.BannerConflictList, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	conflicts, err := g.server.listBannerConflicts()
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	message := &bannerpb.BannerConflictList{}
+	for _, conflict := range conflicts {
+		bannerIDs := make([]int64, len(conflict.BannerIds))
+		for i, id := range conflict.BannerIds {
+			bannerIDs[i] = int64(id)
+		}
+		message.Conflicts = append(message.Conflicts, &bannerpb.BannerConflict{
+			FeatureId: int64(conflict.FeatureId),
+			TagId:     int64(conflict.TagId),
+			BannerIds: bannerIDs,
+		})
+	}
+	return message, nil
+}
+
+// Converts bulk items like decodeBannerBulkItem does. Errors of items are stored in the items, errors of the request are returned
+func bannerBulkItems(req *bannerpb.BulkBannersRequest) (BannerBulkRequestMode, []bannerBulkItem, []FieldError) {
+	mode := Atomic
+	var errs []FieldError
+
+	if req.Mode != "" {
+		mode = BannerBulkRequestMode(req.Mode)
+		if mode != Atomic && mode != BestEffort {
+			errs = append(errs, FieldError{Field: "mode", Message: "must be atomic or best_effort"})
+		}
+	}
+	if len(req.Items) == 0 {
+		return mode, nil, append(errs, FieldError{Field: "items", Message: "must contain at least one item"})
+	}
+	if len(req.Items) > maxBulkItems {
+		return mode, nil, append(errs, FieldError{Field: "items", Message: fmt.Sprintf("must contain at most %d items", maxBulkItems)})
+	}
+
+	items := make([]bannerBulkItem, len(req.Items))
+	for i, message := range req.Items {
+		item := &items[i]
+
+		switch op := message.Op.(type) {
+		case *bannerpb.BulkBannerItem_Create:
+			item.op = Create
+			if op.Create == nil {
+				item.errs = append(item.errs, FieldError{Field: "banner", Message: "is required"})
+				continue
+			}
+
+			item.input = bannerInput(op.Create)
+			for _, fieldErr := range validateBannerInput(item.input) {
+				fieldErr.Field = "banner." + fieldErr.Field
+				item.errs = append(item.errs, fieldErr)
+			}
+		case *bannerpb.BulkBannerItem_Patch:
+			item.op = Patch
+			if op.Patch == nil || op.Patch.Banner == nil {
+				item.errs = append(item.errs, FieldError{Field: "banner", Message: "is required"})
+			}
+			if op.Patch == nil {
+				continue
+			}
+
+			if op.Patch.Id <= 0 {
+				item.errs = append(item.errs, FieldError{Field: "id", Message: "must be a positive integer"})
+			}
+			item.id = int(op.Patch.Id)
+			item.ifMatch = op.Patch.IfMatch
+
+			if op.Patch.Banner != nil {
+				data, err := protojson.Marshal(op.Patch.Banner)
+
+				if err != nil {
+					item.errs = append(item.errs, FieldError{Field: "banner", Message: err.Error()})
+				}
+				item.patch = data
+			}
+		default:
+			item.errs = append(item.errs, FieldError{Field: "op", Message: "must be create or patch"})
+		}
+	}
+	return mode, items, errs
+}
+
+// Unlike POST /banner/bulk, rejected atomic request is not an error of the call, applied is false then
+func (g *grpcServer) BulkBanners(ctx context.Context, req *bannerpb.BulkBannersRequest) (*bannerpb.BulkBannersResponse, error) {
+	token, err := g.authorize(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mode, items, fieldErrs := bannerBulkItems(req)
+
+	if len(fieldErrs) > 0 {
+		return nil, grpcResult(invalidBannerError(fieldErrs), nil)
+	}
+
+	response, err := g.server.applyBannerBulk(mode, items, g.auditSource(ctx, token))
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	message := &bannerpb.BulkBannersResponse{Applied: response.Applied}
+	for _, result := range response.Results {
+		message.Results = append(message.Results, &bannerpb.BulkBannerResult{
+			Index:  int32(result.Index),
+			Status: int32(result.Status),
+			Id:     optionalInt64(result.Id),
+			Etag:   result.Etag,
+			Error:  result.Error,
+			Fields: fieldErrorMessages(result.Fields),
+		})
+	}
+	return message, nil
+}
+
+func (g *grpcServer) BulkDeleteBanners(ctx context.Context, req *bannerpb.BulkDeleteBannersRequest) (*bannerpb.JobAccepted, error) {
+	token, err := g.authorize(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	id, writeErr, err := g.server.enqueueBulkDelete(optionalInt(req.FeatureId), optionalInt(req.TagId), g.auditSource(ctx, token))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return &bannerpb.JobAccepted{JobId: int64(id)}, nil
+}
+
+func (g *grpcServer) GetJob(ctx context.Context, req *bannerpb.GetJobRequest) (*bannerpb.Job, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	job, writeErr, err := g.server.findJob(int(req.Id))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message := &bannerpb.Job{
+		Id:         int64(job.Id),
+		Kind:       job.Kind,
+		Status:     string(job.Status),
+		Total:      int64(job.Total),
+		Processed:  int64(job.Processed),
+		Error:      job.Error,
+		CreatedAt:  timestamppb.New(job.CreatedAt),
+		UpdatedAt:  timestamppb.New(job.UpdatedAt),
+		FinishedAt: timestampMessage(job.FinishedAt),
+	}
+	if message.Params, err = structFromMap(job.Params); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if message.Result, err = structFromMap(job.Result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return message, nil
+}
+
+// Filters of GET /banner/export that export request sets
+func exportFiltersMessage(req *bannerpb.ExportBannersRequest) GetBannerParams {
+	var filters GetBannerParams
+
+	if len(req.FeatureId) > 0 {
+		featureIDs := intIDs(req.FeatureId)
+		filters.FeatureId = &featureIDs
+	}
+	if len(req.TagId) > 0 {
+		tagIDs := intIDs(req.TagId)
+		filters.TagId = &tagIDs
+	}
+	if req.TagMatch != nil {
+		tagMatch := GetBannerParamsTagMatch(*req.TagMatch)
+		filters.TagMatch = &tagMatch
+	}
+	filters.IsActive = req.IsActive
+	filters.CreatedFrom = timestampOrNil(req.CreatedFrom)
+	filters.CreatedTo = timestampOrNil(req.CreatedTo)
+	filters.UpdatedFrom = timestampOrNil(req.UpdatedFrom)
+	filters.UpdatedTo = timestampOrNil(req.UpdatedTo)
+	filters.Q = req.Q
+	filters.ContentPath = req.ContentPath
+	return filters
+}
+
+// Streams matching banners in id order, like GET /banner/export streams lines
+func (g *grpcServer) ExportBanners(req *bannerpb.ExportBannersRequest, stream bannerpb.BannerService_ExportBannersServer) error {
+	if _, err := g.authorize(stream.Context(), true); err != nil {
+		return err
+	}
+
+	rows, writeErr, err := g.server.queryBannerExport(exportFiltersMessage(req))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		banner, err := scanBanner(rows)
+
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		message, err := bannerMessage(banner)
+
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if err := stream.Send(message); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// Converts import records like decodeImportRecord does. Line of record is its number in the request
+func bannerImportRecords(records []*bannerpb.BannerImportInput) []bannerImportRecord {
+	result := make([]bannerImportRecord, len(records))
+	for i, message := range records {
+		record := bannerImportRecord{line: i + 1}
+
+		if message.Id != nil {
+			if *message.Id <= 0 {
+				record.errs = append(record.errs, FieldError{Field: "id", Message: "must be a positive integer"})
+			}
+			record.id = int(*message.Id)
+		}
+		if message.Banner == nil {
+			record.errs = append(record.errs, FieldError{Field: "banner", Message: "is required"})
+		} else {
+			record.input = bannerInput(message.Banner)
+			for _, fieldErr := range validateBannerInput(record.input) {
+				fieldErr.Field = "banner." + fieldErr.Field
+				record.errs = append(record.errs, fieldErr)
+			}
+		}
+		result[i] = record
+	}
+	return result
+}
+
+func (g *grpcServer) ImportBanners(ctx context.Context, req *bannerpb.ImportBannersRequest) (*bannerpb.BannerImportReport, error) {
+	token, err := g.authorize(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mode := Upsert
+	if req.Mode != "" {
+		mode = PostBannerImportParamsMode(req.Mode)
+		if mode != Upsert && mode != SkipExisting {
+			return nil, grpcResult(invalidBannerError([]FieldError{{Field: "mode", Message: "must be upsert or skip_existing"}}), nil)
+		}
+	}
+	if len(req.Records) > maxImportRecords {
+		return nil, grpcResult(invalidBannerError([]FieldError{{Field: "records", Message: fmt.Sprintf("must contain at most %d records", maxImportRecords)}}), nil)
+	}
+
+	report, err := g.server.importBanners(bannerImportRecords(req.Records), mode, req.DryRun, g.auditSource(ctx, token))
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	message := &bannerpb.BannerImportReport{
+		Applied:   report.Applied,
+		DryRun:    report.DryRun,
+		Created:   int32(report.Created),
+		Updated:   int32(report.Updated),
+		Unchanged: int32(report.Unchanged),
+		Skipped:   int32(report.Skipped),
+		Failed:    int32(report.Failed),
+	}
+	for _, record := range report.Records {
+		message.Records = append(message.Records, &bannerpb.BannerImportRecord{
+			Line:   int32(record.Line),
+			Action: string(record.Action),
+			Id:     optionalInt64(record.Id),
+			Status: optionalInt32(record.Status),
+			Error:  record.Error,
+			Fields: fieldErrorMessages(record.Fields),
+		})
+	}
+	return message, nil
+}
+
+func (g *grpcServer) ListTrash(ctx context.Context, req *bannerpb.ListTrashRequest) (*bannerpb.BannerList, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	banners, writeErr, err := g.server.listTrash(optionalInt(req.Limit), optionalInt(req.Offset))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message := &bannerpb.BannerList{}
+	for _, banner := range banners {
+		item, err := bannerMessage(banner)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		message.Banners = append(message.Banners, item)
+	}
+	return message, nil
+}
+
+func (g *grpcServer) RestoreBanner(ctx context.Context, req *bannerpb.RestoreBannerRequest) (*bannerpb.BannerRevision, error) {
+	token, err := g.authorize(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	revision, writeErr, err := g.server.restoreBanner(int(req.Id), g.auditSource(ctx, token))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return bannerRevisionMessage(revision), nil
+}
+
+func catalogEntryMessage(entry Feature) *bannerpb.CatalogEntry {
+	return &bannerpb.CatalogEntry{
+		Id:          int64(entry.Id),
+		Name:        entry.Name,
+		Description: entry.Description,
+		Owner:       entry.Owner,
+		Archived:    entry.Archived,
+		CreatedAt:   timestamppb.New(entry.CreatedAt),
+		UpdatedAt:   timestamppb.New(entry.UpdatedAt),
+	}
+}
+
+// Converts and checks feature or tag fields. Missing message is the same as message without fields
+func catalogInputMessage(message *bannerpb.CatalogEntryInput, create bool) (catalogInput, error) {
+	var input catalogInput
+	if message != nil {
+		input = catalogInput{name: message.Name, description: message.Description, owner: message.Owner, archived: message.Archived}
+	}
+
+	if fieldErrs := validateCatalogInput(&input, create); len(fieldErrs) > 0 {
+		return input, grpcResult(invalidBannerError(fieldErrs), nil)
+	}
+	return input, nil
+}
+
+func (g *grpcServer) listCatalog(ctx context.Context, c catalog, req *bannerpb.ListCatalogRequest) (*bannerpb.CatalogEntryList, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	entries, writeErr, err := g.server.catalogEntries(c, req.Archived, optionalInt(req.Limit), optionalInt(req.Offset))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message := &bannerpb.CatalogEntryList{}
+	for _, entry := range entries {
+		message.Entries = append(message.Entries, catalogEntryMessage(entry))
+	}
+	return message, nil
+}
+
+func (g *grpcServer) getCatalogEntry(ctx context.Context, c catalog, req *bannerpb.CatalogEntryRequest) (*bannerpb.CatalogEntry, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	entry, writeErr, err := g.server.findCatalogEntry(c, int(req.Id))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return catalogEntryMessage(entry), nil
+}
+
+func (g *grpcServer) createCatalogEntry(ctx context.Context, c catalog, req *bannerpb.CatalogEntryInput) (*bannerpb.CatalogEntry, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	input, err := catalogInputMessage(req, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry, writeErr, err := g.server.insertCatalogEntry(c, input)
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return catalogEntryMessage(entry), nil
+}
+
+func (g *grpcServer) updateCatalogEntry(ctx context.Context, c catalog, req *bannerpb.UpdateCatalogEntryRequest) (*bannerpb.CatalogEntry, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	input, err := catalogInputMessage(req.Entry, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry, writeErr, err := g.server.patchCatalogEntry(c, int(req.Id), input)
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return catalogEntryMessage(entry), nil
+}
+
+func (g *grpcServer) deleteCatalogEntry(ctx context.Context, c catalog, req *bannerpb.CatalogEntryRequest) (*emptypb.Empty, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	if err := grpcResult(g.server.removeCatalogEntry(c, int(req.Id))); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) ListFeatures(ctx context.Context, req *bannerpb.ListCatalogRequest) (*bannerpb.CatalogEntryList, error) {
+	return g.listCatalog(ctx, featureCatalog, req)
+}
+
+func (g *grpcServer) GetFeature(ctx context.Context, req *bannerpb.CatalogEntryRequest) (*bannerpb.CatalogEntry, error) {
+	return g.getCatalogEntry(ctx, featureCatalog, req)
+}
+
+func (g *grpcServer) CreateFeature(ctx context.Context, req *bannerpb.CatalogEntryInput) (*bannerpb.CatalogEntry, error) {
+	return g.createCatalogEntry(ctx, featureCatalog, req)
+}
+
+func (g *grpcServer) UpdateFeature(ctx context.Context, req *bannerpb.UpdateCatalogEntryRequest) (*bannerpb.CatalogEntry, error) {
+	return g.updateCatalogEntry(ctx, featureCatalog, req)
+}
+
+func (g *grpcServer) DeleteFeature(ctx context.Context, req *bannerpb.CatalogEntryRequest) (*emptypb.Empty, error) {
+	return g.deleteCatalogEntry(ctx, featureCatalog, req)
+}
+
+func (g *grpcServer) GetFeatureSchema(ctx context.Context, req *bannerpb.CatalogEntryRequest) (*bannerpb.FeatureSchema, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	data, writeErr, err := g.server.findContentSchema(int(req.Id))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	schema, err := structFromJSON(data)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &bannerpb.FeatureSchema{Schema: schema}, nil
+}
+
+func (g *grpcServer) SetFeatureSchema(ctx context.Context, req *bannerpb.SetFeatureSchemaRequest) (*bannerpb.JobAccepted, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+	if req.Schema == nil {
+		return nil, grpcResult(invalidBannerError([]FieldError{{Field: "schema", Message: "is required"}}), nil)
+	}
+
+	data, err := protojson.Marshal(req.Schema)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, writeErr, err := g.server.setContentSchema(int(req.Id), data)
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return &bannerpb.JobAccepted{JobId: int64(id)}, nil
+}
+
+func (g *grpcServer) DeleteFeatureSchema(ctx context.Context, req *bannerpb.CatalogEntryRequest) (*emptypb.Empty, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	if err := grpcResult(g.server.removeContentSchema(int(req.Id))); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) ListTags(ctx context.Context, req *bannerpb.ListCatalogRequest) (*bannerpb.CatalogEntryList, error) {
+	return g.listCatalog(ctx, tagCatalog, req)
+}
+
+func (g *grpcServer) GetTag(ctx context.Context, req *bannerpb.CatalogEntryRequest) (*bannerpb.CatalogEntry, error) {
+	return g.getCatalogEntry(ctx, tagCatalog, req)
+}
+
+func (g *grpcServer) CreateTag(ctx context.Context, req *bannerpb.CatalogEntryInput) (*bannerpb.CatalogEntry, error) {
+	return g.createCatalogEntry(ctx, tagCatalog, req)
+}
+
+func (g *grpcServer) UpdateTag(ctx context.Context, req *bannerpb.UpdateCatalogEntryRequest) (*bannerpb.CatalogEntry, error) {
+	return g.updateCatalogEntry(ctx, tagCatalog, req)
+}
+
+func (g *grpcServer) DeleteTag(ctx context.Context, req *bannerpb.CatalogEntryRequest) (*emptypb.Empty, error) {
+	return g.deleteCatalogEntry(ctx, tagCatalog, req)
+}
+
+func webhookMessage(webhook Webhook) *bannerpb.Webhook {
+	events := make([]string, len(webhook.Events))
+	for i, event := range webhook.Events {
+		events[i] = string(event)
+	}
+
+	return &bannerpb.Webhook{
+		Id:        int64(webhook.Id),
+		Url:       webhook.Url,
+		Events:    events,
+		Active:    webhook.Active,
+		CreatedAt: timestamppb.New(webhook.CreatedAt),
+		UpdatedAt: timestamppb.New(webhook.UpdatedAt),
+	}
+}
+
+// Converts and checks webhook fields. Empty events are not sent, so on update they keep events of the webhook
+func webhookInputMessage(message *bannerpb.WebhookInput, create bool) (webhookInput, error) {
+	var input webhookInput
+	if message != nil {
+		input = webhookInput{url: message.Url, secret: message.Secret, active: message.Active}
+		if len(message.Events) > 0 {
+			input.events = message.Events
+		}
+	}
+
+	if fieldErrs := validateWebhookInput(&input, create); len(fieldErrs) > 0 {
+		return input, grpcResult(invalidBannerError(fieldErrs), nil)
+	}
+	return input, nil
+}
+
+func webhookDeliveryMessage(delivery WebhookDelivery) (*bannerpb.WebhookDelivery, error) {
+	changes, err := auditChangeMessages(delivery.Event.Changes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &bannerpb.WebhookDelivery{
+		Id:            int64(delivery.Id),
+		WebhookId:     int64(delivery.WebhookId),
+		Status:        string(delivery.Status),
+		Attempts:      int32(delivery.Attempts),
+		NextAttemptAt: timestampMessage(delivery.NextAttemptAt),
+		LastStatus:    optionalInt32(delivery.LastStatus),
+		LastError:     delivery.LastError,
+		CreatedAt:     timestamppb.New(delivery.CreatedAt),
+		DeliveredAt:   timestampMessage(delivery.DeliveredAt),
+		Event: &bannerpb.WebhookEvent{
+			Id:         int64(delivery.Event.Id),
+			Type:       string(delivery.Event.Type),
+			BannerId:   int64(delivery.Event.BannerId),
+			Changes:    changes,
+			OccurredAt: timestamppb.New(delivery.Event.OccurredAt),
+		},
+	}, nil
+}
+
+func (g *grpcServer) ListWebhooks(ctx context.Context, req *bannerpb.ListWebhooksRequest) (*bannerpb.WebhookList, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	webhooks, writeErr, err := g.server.listWebhooks(optionalInt(req.Limit), optionalInt(req.Offset))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message := &bannerpb.WebhookList{}
+	for _, webhook := range webhooks {
+		message.Webhooks = append(message.Webhooks, webhookMessage(webhook))
+	}
+	return message, nil
+}
+
+func (g *grpcServer) GetWebhook(ctx context.Context, req *bannerpb.WebhookRequest) (*bannerpb.Webhook, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	webhook, writeErr, err := g.server.findWebhook(int(req.Id))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return webhookMessage(webhook), nil
+}
+
+func (g *grpcServer) CreateWebhook(ctx context.Context, req *bannerpb.WebhookInput) (*bannerpb.Webhook, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	input, err := webhookInputMessage(req, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := g.server.createWebhook(input)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	message := webhookMessage(Webhook{
+		Id:        created.Id,
+		Url:       created.Url,
+		Events:    created.Events,
+		Active:    created.Active,
+		CreatedAt: created.CreatedAt,
+		UpdatedAt: created.UpdatedAt,
+	})
+	message.Secret = created.Secret
+	return message, nil
+}
+
+func (g *grpcServer) UpdateWebhook(ctx context.Context, req *bannerpb.UpdateWebhookRequest) (*bannerpb.Webhook, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	input, err := webhookInputMessage(req.Webhook, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, writeErr, err := g.server.updateWebhook(int(req.Id), input)
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+	return webhookMessage(webhook), nil
+}
+
+func (g *grpcServer) DeleteWebhook(ctx context.Context, req *bannerpb.WebhookRequest) (*emptypb.Empty, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	if err := grpcResult(g.server.removeWebhook(int(req.Id))); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) ListDeadLetters(ctx context.Context, req *bannerpb.ListDeadLettersRequest) (*bannerpb.WebhookDeliveryList, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	deliveries, writeErr, err := g.server.deadLetters(int(req.WebhookId), optionalInt(req.Limit), optionalInt(req.Offset))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message := &bannerpb.WebhookDeliveryList{}
+	for _, delivery := range deliveries {
+		item, err := webhookDeliveryMessage(delivery)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		message.Deliveries = append(message.Deliveries, item)
+	}
+	return message, nil
+}
+
+func (g *grpcServer) RedeliverWebhookDelivery(ctx context.Context, req *bannerpb.RedeliverWebhookDeliveryRequest) (*bannerpb.WebhookDelivery, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	delivery, writeErr, err := g.server.redeliver(int(req.WebhookId), int(req.DeliveryId))
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message, err := webhookDeliveryMessage(delivery)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return message, nil
+}
+
+func (g *grpcServer) ListAudit(ctx context.Context, req *bannerpb.ListAuditRequest) (*bannerpb.AuditPage, error) {
+	if _, err := g.authorize(ctx, true); err != nil {
+		return nil, err
+	}
+
+	params := GetAuditParams{
+		Actor:    req.Actor,
+		Action:   req.Action,
+		BannerId: optionalInt(req.BannerId),
+		From:     timestampOrNil(req.From),
+		To:       timestampOrNil(req.To),
+		Limit:    optionalInt(req.Limit),
+		Cursor:   req.Cursor,
+	}
+	page, writeErr, err := g.server.listAudit(params)
+
+	if err := grpcResult(writeErr, err); err != nil {
+		return nil, err
+	}
+
+	message := &bannerpb.AuditPage{NextCursor: page.nextCursor}
+	for _, entry := range page.entries {
+		diff, err := auditChangeMessages(entry.Diff)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		message.Entries = append(message.Entries, &bannerpb.AuditEntry{
+			Id:        int64(entry.Id),
+			CreatedAt: timestamppb.New(entry.CreatedAt),
+			Actor:     entry.Actor,
+			Action:    entry.Action,
+			BannerId:  optionalInt64(entry.BannerId),
+			Diff:      diff,
+			RequestId: entry.RequestId,
+			SourceIp:  entry.SourceIp,
+		})
+	}
+	return message, nil
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Redych-the-kid/go_projects/golang_banner/bannerpb"
+	"github.com/go-redis/redismock/v8"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newGRPCTestClient(t *testing.T) (bannerpb.BannerServiceClient, *Server, sqlmock.Sqlmock, redismock.ClientMock) {
+	server, db_mock, cache_mock := newTestServer(t)
+	server.streams = newBannerStreamHub()
+
+	listener := bufconn.Listen(1 << 20)
+	g := newGRPCServer(server)
+	go g.Serve(listener)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+
+	if err != nil {
+		t.Fatalf("Error occcured: %s", err.Error())
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		g.Stop()
+	})
+	return bannerpb.NewBannerServiceClient(conn), server, db_mock, cache_mock
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "token", token)
+}
+
+func TestGRPCGetUserBanner(t *testing.T) {
+	client, _, db_mock, cache_mock := newGRPCTestClient(t)
+
+	jsonData := []byte(`{"title": "a"}`)
+	expectUserBannerCacheMiss(cache_mock, "2:5,3")
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{5, 3})).
+		WillReturnRows(sqlmock.NewRows([]string{"content", "is_active", "tag"}).AddRow(jsonData, true, 3))
+	expectCacheUserBanner(cache_mock, "2:5,3", jsonData, true, 3)
+
+	var header metadata.MD
+	banner, err := client.GetUserBanner(withToken("IMACREEP"), &bannerpb.GetUserBannerRequest{FeatureId: 2, TagId: []int64{5, 3, 5}}, grpc.Header(&header))
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{"title": "a"}, banner.Content.AsMap())
+		assert.Equal(t, int64(3), banner.MatchedTagId)
+		assert.Equal(t, contentETag(jsonData), banner.Etag)
+		assert.NotEmpty(t, header.Get("x-request-id"))
+	}
+
+	// Inactive banner from cache is hidden from users
+	expectCachedUserBanner(cache_mock, "2:3", jsonData, false, 3)
+	_, err = client.GetUserBanner(withToken("IMACREEP"), &bannerpb.GetUserBannerRequest{FeatureId: 2, TagId: []int64{3}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGRPCListBanners(t *testing.T) {
+	client, _, db_mock, _ := newGRPCTestClient(t)
+	now := time.Date(2024, 4, 10, 12, 30, 0, 0, time.UTC)
+
+	db_mock.ExpectQuery("SELECT count(*) FROM banners WHERE deleted_at IS NULL AND feature_id = ANY($1::int[])").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	rows := sqlmock.NewRows([]string{"id", "tag_ids", "feature_id", "content", "is_active", "created_at", "updated_at", "revision", "priority", "deleted_at", "state", "published_revision", "published_at", "review_comment"}).
+		AddRow(1, "{1,2}", 2, []byte(`{"title":"a"}`), true, now, now, 4, 0, nil, "published", 4, now, nil).
+		AddRow(2, "{1}", 2, []byte(`{}`), false, now, now, 1, 0, nil, "draft", nil, nil, nil)
+	db_mock.ExpectQuery("SELECT " + bannerColumns + " FROM banners WHERE deleted_at IS NULL AND feature_id = ANY($1::int[]) ORDER BY id ASC LIMIT $2").
+		WillReturnRows(rows)
+
+	limit := int64(1)
+	page, err := client.ListBanners(withToken("IGOTTHEPOWER!"), &bannerpb.ListBannersRequest{FeatureId: []int64{2}, Limit: &limit})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(3), page.TotalCount)
+
+		cursor, err := decodeBannerCursor(page.NextCursor, Id, Asc)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), cursor.ID)
+
+		if assert.Len(t, page.Banners, 1) {
+			banner := page.Banners[0]
+			assert.Equal(t, []int64{1, 2}, banner.TagIds)
+			assert.Equal(t, "a", banner.Content.AsMap()["title"])
+			assert.Equal(t, "published", banner.State)
+			assert.Equal(t, int64(4), banner.GetPublishedRevision())
+			assert.Equal(t, now, banner.PublishedAt.AsTime())
+			assert.Equal(t, `"4"`, banner.Etag)
+		}
+	}
+
+	// Filters are checked like query parameters of GET /banner
+	order := "up"
+	_, err = client.ListBanners(withToken("IGOTTHEPOWER!"), &bannerpb.ListBannersRequest{Order: &order})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		badRequest := st.Details()[0].(*errdetails.BadRequest)
+		assert.Equal(t, "order", badRequest.FieldViolations[0].Field)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGRPCCreateBanner(t *testing.T) {
+	client, _, db_mock, _ := newGRPCTestClient(t)
+
+	db_mock.ExpectBegin()
+	expectCreateBanner(db_mock, 2, []int{3}, 0, 9)
+	db_mock.ExpectCommit()
+
+	content, _ := structpb.NewStruct(map[string]interface{}{"title": "a"})
+	banner := &bannerpb.BannerInput{FeatureId: 2, TagIds: []int64{3}, Content: content, IsActive: true}
+	created, err := client.CreateBanner(withToken("IGOTTHEPOWER!"), &bannerpb.CreateBannerRequest{Banner: banner})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(9), created.BannerId)
+	}
+
+	// Field errors come as BadRequest with field names of REST API
+	banner = &bannerpb.BannerInput{FeatureId: 2, TagIds: []int64{3, 3}}
+	_, err = client.CreateBanner(withToken("IGOTTHEPOWER!"), &bannerpb.CreateBannerRequest{Banner: banner})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		var fields []string
+		for _, violation := range st.Details()[0].(*errdetails.BadRequest).FieldViolations {
+			fields = append(fields, violation.Field)
+		}
+		assert.Equal(t, []string{"tag_ids[1]", "content"}, fields)
+	}
+
+	// Banner of the same feature, tag and priority exists
+	db_mock.ExpectBegin()
+	expectCreateBanner(db_mock, 2, []int{3}, 4, 0)
+	db_mock.ExpectRollback()
+
+	banner = &bannerpb.BannerInput{FeatureId: 2, TagIds: []int64{3}, Content: content, IsActive: true}
+	_, err = client.CreateBanner(withToken("IGOTTHEPOWER!"), &bannerpb.CreateBannerRequest{Banner: banner})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGRPCErrors(t *testing.T) {
+	client, server, db_mock, _ := newGRPCTestClient(t)
+
+	_, err := client.GetBanner(withToken("nobody"), &bannerpb.GetBannerRequest{Id: 7})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetBanner(withToken("IMACREEP"), &bannerpb.GetBannerRequest{Id: 7})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	db_mock.ExpectQuery("SELECT " + bannerColumns + " FROM banners WHERE id = $1 AND deleted_at IS NULL").
+		WithArgs(7).
+		WillReturnError(sql.ErrNoRows)
+	_, err = client.GetBanner(withToken("IGOTTHEPOWER!"), &bannerpb.GetBannerRequest{Id: 7})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Reject needs a review comment
+	_, err = client.RejectBanner(withToken("IGOTTHEPOWER!"), &bannerpb.BannerTransitionRequest{Id: 7})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	server.requireIfMatch = true
+	_, err = client.DeleteBanner(withToken("IGOTTHEPOWER!"), &bannerpb.DeleteBannerRequest{Id: 7})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGRPCWatchUserBanner(t *testing.T) {
+	client, server, db_mock, _ := newGRPCTestClient(t)
+
+	expectStreamBanner(db_mock, `{"title": "a"}`, true)
+	expectStreamBanner(db_mock, `{"title": "b"}`, false)
+
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(withToken("IMACREEP"), "x-request-id", "watch-1"))
+	defer cancel()
+	stream, err := client.WatchUserBanner(ctx, &bannerpb.WatchUserBannerRequest{FeatureId: 2, TagId: []int64{3, 3}})
+
+	if err != nil {
+		t.Fatalf("Error occcured: %s", err.Error())
+	}
+
+	// Stream interceptor returns request ID like the unary one
+	header, err := stream.Header()
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"watch-1"}, header.Get("x-request-id"))
+	}
+
+	event, err := stream.Recv()
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, strings.Trim(contentETag([]byte(`{"title": "a"}`)), `"`), event.Id)
+		assert.Equal(t, "a", event.GetBanner().Content.AsMap()["title"])
+		assert.Equal(t, int64(3), event.GetBanner().MatchedTagId)
+	}
+
+	server.streams.notify(2)
+	event, err = stream.Recv()
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(403), event.GetUnavailableStatus())
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGRPCWatchUserBannerUnauthorized(t *testing.T) {
+	client, _, _, _ := newGRPCTestClient(t)
+
+	stream, err := client.WatchUserBanner(withToken("nobody"), &bannerpb.WatchUserBannerRequest{FeatureId: 2, TagId: []int64{3}})
+
+	if assert.NoError(t, err) {
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+}
+
+func TestGRPCCreateFeature(t *testing.T) {
+	client, _, db_mock, _ := newGRPCTestClient(t)
+	now := time.Now()
+
+	name, owner := "  checkout ", "payments"
+	db_mock.ExpectQuery("INSERT INTO features (name, description, owner, archived) VALUES ($1, $2, $3, $4) RETURNING "+catalogColumns).
+		WithArgs("checkout", "", "payments", false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "owner", "archived", "created_at", "updated_at"}).
+			AddRow(3, "checkout", "", "payments", false, now, now))
+	feature, err := client.CreateFeature(withToken("IGOTTHEPOWER!"), &bannerpb.CatalogEntryInput{Name: &name, Owner: &owner})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(3), feature.Id)
+		assert.Equal(t, "checkout", feature.Name)
+	}
+
+	blank := " "
+	_, err = client.CreateFeature(withToken("IGOTTHEPOWER!"), &bannerpb.CatalogEntryInput{Name: &blank})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateTag(withToken("IMACREEP"), &bannerpb.CatalogEntryInput{Name: &name})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGRPCCreateWebhook(t *testing.T) {
+	client, _, db_mock, _ := newGRPCTestClient(t)
+	now := time.Now()
+
+	url := "https://cdn.example.com/purge"
+	db_mock.ExpectQuery("INSERT INTO webhooks (url, secret, events, active) VALUES ($1, $2, $3, $4) RETURNING "+webhookColumns).
+		WithArgs(url, sqlmock.AnyArg(), pq.Array([]string{"updated", "deleted"}), true).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "events", "active", "created_at", "updated_at"}).
+			AddRow(3, url, "{updated,deleted}", true, now, now))
+	webhook, err := client.CreateWebhook(withToken("IGOTTHEPOWER!"), &bannerpb.WebhookInput{Url: &url, Events: []string{"updated", "deleted", "updated"}})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(3), webhook.Id)
+		assert.Len(t, webhook.Secret, 64)
+		assert.Equal(t, []string{"updated", "deleted"}, webhook.Events)
+	}
+
+	ftp := "ftp://cdn.example.com"
+	_, err = client.CreateWebhook(withToken("IGOTTHEPOWER!"), &bannerpb.WebhookInput{Url: &ftp, Events: []string{"moved"}})
+
+	if assert.Equal(t, codes.InvalidArgument, status.Code(err)) {
+		details := status.Convert(err).Details()
+
+		if assert.Len(t, details, 1) {
+			violations := details[0].(*errdetails.BadRequest).FieldViolations
+			if assert.Len(t, violations, 2) {
+				assert.Equal(t, "url", violations[0].Field)
+				assert.Equal(t, "events[0]", violations[1].Field)
+			}
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGRPCRequestValidation(t *testing.T) {
+	client, _, _, _ := newGRPCTestClient(t)
+
+	_, err := client.BulkBanners(withToken("IGOTTHEPOWER!"), &bannerpb.BulkBannersRequest{Mode: "all"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.ImportBanners(withToken("IGOTTHEPOWER!"), &bannerpb.ImportBannersRequest{Mode: "replace"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.BulkDeleteBanners(withToken("IGOTTHEPOWER!"), &bannerpb.BulkDeleteBannersRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Batch lookup is a user method, user token passes authorization and fails validation
+	_, err = client.GetUserBannerBatch(withToken("IMACREEP"), &bannerpb.UserBannerBatchRequest{Items: []*bannerpb.UserBannerBatchItem{{FeatureId: 2}}})
+
+	if assert.Equal(t, codes.InvalidArgument, status.Code(err)) {
+		details := status.Convert(err).Details()
+
+		if assert.Len(t, details, 1) {
+			assert.Equal(t, "items[0].tag_id", details[0].(*errdetails.BadRequest).FieldViolations[0].Field)
+		}
+	}
+}
+
+func TestGRPCGetReadiness(t *testing.T) {
+	client, server, db_mock, cache_mock := newGRPCTestClient(t)
+	server.schemaVersion = 2
+
+	db_mock.ExpectQuery("SELECT coalesce(max(version), 0) FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	cache_mock.ExpectPing().SetVal("PONG")
+
+	// Readiness needs no token
+	readiness, err := client.GetReadiness(context.Background(), &emptypb.Empty{})
+
+	if assert.NoError(t, err) {
+		assert.False(t, readiness.Ready)
+		assert.Equal(t, int64(1), readiness.SchemaVersion)
+		assert.Equal(t, int64(2), readiness.ExpectedSchemaVersion)
+		assert.Len(t, readiness.Errors, 1)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
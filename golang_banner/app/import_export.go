@@ -0,0 +1,572 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+// Media types of banner export and import
+const (
+	mimeNDJSON = "application/x-ndjson"
+	mimeCSV    = "text/csv"
+)
+
+// Limits of banner import. Export is streamed and has no limits
+const (
+	maxImportRequestSize = 64 * 1024 * 1024
+	maxImportRecords     = 100000
+	// Export response is flushed to the client after every exportFlushRows banners
+	exportFlushRows = 100
+)
+
+// Columns of CSV export in the order they are written
+var bannerCSVColumns = []string{"id", "feature_id", "tag_ids", "content", "is_active", "priority", "revision", "created_at", "updated_at"}
+
+func (s *Server) GetBannerExport(ctx echo.Context, params GetBannerExportParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	filters := exportFilters(params)
+	fieldErrs := validateBannerFilters(filters)
+	format := Ndjson
+
+	if params.Format != nil {
+		format = *params.Format
+		if format != Ndjson && format != Csv {
+			fieldErrs = append(fieldErrs, FieldError{Field: "format", Message: "must be ndjson or csv"})
+		}
+	}
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+
+	rows, writeErr, err := s.queryBannerExport(filters)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	defer rows.Close()
+
+	response := ctx.Response()
+	writer := newBannerExportWriter(format, response)
+	response.Header().Set(echo.HeaderContentType, writer.contentType())
+	response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"banners.%s\"", format))
+	response.WriteHeader(http.StatusOK)
+
+	// Status is already sent, so errors below only cut the stream short
+	exported := 0
+	for rows.Next() {
+		banner, err := scanBanner(rows)
+		if err != nil {
+			return err
+		}
+		if err := writer.write(banner); err != nil {
+			return err
+		}
+
+		exported++
+		if exported%exportFlushRows == 0 {
+			if err := writer.flush(); err != nil {
+				return err
+			}
+			response.Flush()
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := writer.flush(); err != nil {
+		return err
+	}
+	response.Flush()
+	return nil
+}
+
+// Checks filters and selects matching banners in id order, rows are read by the caller. Used by REST and gRPC APIs
+func (s *Server) queryBannerExport(filters GetBannerParams) (*sql.Rows, *bannerWriteError, error) {
+	if fieldErrs := validateBannerFilters(filters); len(fieldErrs) > 0 {
+		return nil, invalidBannerError(fieldErrs), nil
+	}
+
+	filter, args := bannerFilterBuilder(filters)
+	rows, err := s.db.Query("SELECT "+bannerColumns+" FROM banners"+filter+" ORDER BY id", args...)
+
+	if err != nil && isContentPathError(err) {
+		return nil, invalidBannerError([]FieldError{{Field: "content_path", Message: err.Error()}}), nil
+	}
+	return rows, nil, err
+}
+
+// Builds GetBanner filters from export parameters
+func exportFilters(params GetBannerExportParams) GetBannerParams {
+	filters := GetBannerParams{
+		FeatureId:   params.FeatureId,
+		TagId:       params.TagId,
+		IsActive:    params.IsActive,
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		UpdatedFrom: params.UpdatedFrom,
+		UpdatedTo:   params.UpdatedTo,
+		Q:           params.Q,
+		ContentPath: params.ContentPath,
+	}
+
+	if params.TagMatch != nil {
+		tagMatch := GetBannerParamsTagMatch(*params.TagMatch)
+		filters.TagMatch = &tagMatch
+	}
+	return filters
+}
+
+// Writes exported banners in one of export formats
+type bannerExportWriter interface {
+	contentType() string
+	write(banner Banner) error
+	flush() error
+}
+
+func newBannerExportWriter(format GetBannerExportParamsFormat, w io.Writer) bannerExportWriter {
+	if format == Csv {
+		return &csvExportWriter{writer: csv.NewWriter(w)}
+	}
+	return &ndjsonExportWriter{encoder: json.NewEncoder(w)}
+}
+
+// Writes banners as JSON Lines, one GetBanner item per line
+type ndjsonExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *ndjsonExportWriter) contentType() string {
+	return mimeNDJSON
+}
+
+func (w *ndjsonExportWriter) write(banner Banner) error {
+	return w.encoder.Encode(banner)
+}
+
+func (w *ndjsonExportWriter) flush() error {
+	return nil
+}
+
+// Writes banners as CSV with header row. Tags and content are written as JSON
+type csvExportWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvExportWriter) contentType() string {
+	return mimeCSV + "; charset=utf-8"
+}
+
+func (w *csvExportWriter) write(banner Banner) error {
+	if !w.headerWritten {
+		if err := w.writer.Write(bannerCSVColumns); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+
+	tagIDs, err := json.Marshal(banner.TagIDs)
+
+	if err != nil {
+		return err
+	}
+	return w.writer.Write([]string{
+		strconv.FormatInt(banner.ID, 10),
+		strconv.Itoa(banner.FeatureID),
+		string(tagIDs),
+		string(banner.Content),
+		strconv.FormatBool(banner.IsActive),
+		strconv.Itoa(banner.Priority),
+		strconv.FormatInt(banner.Revision, 10),
+		banner.CreatedAt.Format(time.RFC3339Nano),
+		banner.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
+
+func (w *csvExportWriter) flush() error {
+	if !w.headerWritten {
+		if err := w.writer.Write(bannerCSVColumns); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (s *Server) PostBannerImport(ctx echo.Context, params PostBannerImportParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	mode := Upsert
+	if params.Mode != nil {
+		mode = *params.Mode
+		if mode != Upsert && mode != SkipExisting {
+			return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "mode", Message: "must be upsert or skip_existing"}}))
+		}
+	}
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	mediaType, _, err := mime.ParseMediaType(ctx.Request().Header.Get(echo.HeaderContentType))
+
+	if err != nil || (mediaType != mimeNDJSON && mediaType != mimeCSV) {
+		return ctx.HTML(http.StatusUnsupportedMediaType, "Поддерживаются только application/x-ndjson и text/csv")
+	}
+
+	data, err := readRequestBodyLimit(ctx.Request().Body, maxImportRequestSize)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	var records []bannerImportRecord
+	var fieldErrs []FieldError
+	if mediaType == mimeCSV {
+		records, fieldErrs = decodeCSVImport(data)
+	} else {
+		records, fieldErrs = decodeNDJSONImport(data)
+	}
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+
+	report, err := s.importBanners(records, mode, dryRun, s.auditSource(ctx, *params.Token))
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if report.Failed > 0 {
+		return ctx.JSON(http.StatusUnprocessableEntity, report)
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// Splits JSON Lines import into records. Empty lines are skipped
+func decodeNDJSONImport(data []byte) ([]bannerImportRecord, []FieldError) {
+	var records []bannerImportRecord
+
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		if len(records) == maxImportRecords {
+			return nil, []FieldError{{Field: "body", Message: fmt.Sprintf("must contain at most %d records", maxImportRecords)}}
+		}
+
+		var raw map[string]json.RawMessage
+
+		if err := json.Unmarshal(line, &raw); err != nil || raw == nil {
+			records = append(records, bannerImportRecord{line: i + 1, errs: []FieldError{{Field: "body", Message: "must be a JSON object"}}})
+			continue
+		}
+		records = append(records, decodeImportRecord(i+1, raw))
+	}
+	return records, nil
+}
+
+// Splits CSV import into records. First row names columns, empty cells are treated as missing fields
+func decodeCSVImport(data []byte) ([]bannerImportRecord, []FieldError) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	header, err := reader.Read()
+
+	if err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, []FieldError{{Field: "body", Message: err.Error()}}
+	}
+
+	known := map[string]bool{"id": true}
+	for _, field := range append(bannerInputFields, bannerExportOnlyFields...) {
+		known[field] = true
+	}
+
+	var errs []FieldError
+	seen := map[string]bool{}
+	for _, column := range header {
+		if !known[column] {
+			errs = append(errs, FieldError{Field: column, Message: "unknown column"})
+		} else if seen[column] {
+			errs = append(errs, FieldError{Field: column, Message: "duplicate column"})
+		}
+		seen[column] = true
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	var records []bannerImportRecord
+	for {
+		row, err := reader.Read()
+
+		if err == io.EOF {
+			return records, nil
+		} else if err != nil {
+			return nil, []FieldError{{Field: "body", Message: err.Error()}}
+		}
+		if len(records) == maxImportRecords {
+			return nil, []FieldError{{Field: "body", Message: fmt.Sprintf("must contain at most %d records", maxImportRecords)}}
+		}
+
+		line, _ := reader.FieldPos(0)
+		raw := map[string]json.RawMessage{}
+		for i, cell := range row {
+			if cell != "" {
+				raw[header[i]] = json.RawMessage(cell)
+			}
+		}
+		records = append(records, decodeImportRecord(line, raw))
+	}
+}
+
+// Applies import records in one transaction. Transaction is committed only if every record passed and it is not a dry run
+func (s *Server) importBanners(records []bannerImportRecord, mode PostBannerImportParamsMode, dryRun bool, audit auditSource) (BannerImportReport, error) {
+	report := BannerImportReport{DryRun: dryRun, Records: []BannerImportRecord{}}
+	tx, err := s.db.Begin()
+
+	if err != nil {
+		return report, err
+	}
+	defer tx.Rollback()
+
+	maxInsertedID := 0
+	var restoredFeatureIDs []int
+	for _, record := range records {
+		result := BannerImportRecord{Line: record.line}
+		if record.id != 0 {
+			id := record.id
+			result.Id = &id
+		}
+
+		var writeErr *bannerWriteError
+		if len(record.errs) > 0 {
+			writeErr = invalidBannerError(record.errs)
+		} else {
+			var id int
+			result.Action, id, writeErr, err = importBanner(tx, record, mode, audit, &restoredFeatureIDs)
+
+			if err != nil {
+				return report, err
+			}
+			if writeErr == nil {
+				result.Id = &id
+			}
+			if writeErr == nil && result.Action == BannerImportRecordActionCreated && record.id > maxInsertedID {
+				maxInsertedID = record.id
+			}
+		}
+
+		if writeErr != nil {
+			result.Action = BannerImportRecordActionFailed
+			result.Status = &writeErr.status
+			result.Error = stringPtr(writeErr.message)
+
+			if writeErr.fields != nil {
+				fields := writeErr.fields
+				result.Fields = &fields
+			}
+		}
+
+		switch result.Action {
+		case BannerImportRecordActionCreated:
+			report.Created++
+		case BannerImportRecordActionUpdated:
+			report.Updated++
+		case BannerImportRecordActionUnchanged:
+			report.Unchanged++
+			continue
+		case BannerImportRecordActionSkipped:
+			report.Skipped++
+		case BannerImportRecordActionFailed:
+			report.Failed++
+		}
+		report.Records = append(report.Records, result)
+	}
+
+	if dryRun || report.Failed > 0 {
+		return report, nil
+	}
+
+	// Banners created with explicit ids don't advance the id sequence, so it is moved past them
+	if maxInsertedID > 0 {
+		query := "SELECT setval(pg_get_serial_sequence('banners', 'id'), greatest(nextval(pg_get_serial_sequence('banners', 'id')), $1))"
+		if _, err := tx.Exec(query, maxInsertedID); err != nil {
+			return report, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return report, err
+	}
+	report.Applied = true
+
+	// Published revisions of banners brought back from trash are served to users again
+	if len(restoredFeatureIDs) > 0 {
+		sort.Ints(restoredFeatureIDs)
+		if err := invalidateFeatureCache(s.ctx, s.cache, uniqueIDs(restoredFeatureIDs)); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// Applies one import record inside tx and logs the change to audit. Returns what happened to the banner and its id.
+// Banners in trash are restored, features of their published revisions are appended to restoredFeatureIDs
+func importBanner(tx *sql.Tx, record bannerImportRecord, mode PostBannerImportParamsMode, audit auditSource, restoredFeatureIDs *[]int) (BannerImportRecordAction, int, *bannerWriteError, error) {
+	if record.id == 0 {
+		id, writeErr, err := createBanner(tx, record.input, audit)
+		return BannerImportRecordActionCreated, id, writeErr, err
+	}
+
+	var banner Banner
+	var publishedFeatureID sql.NullInt64
+	query := "SELECT tag_ids, feature_id, content, is_active, priority, deleted_at, published_feature_id FROM banners WHERE id = $1 FOR UPDATE"
+	err := tx.QueryRow(query, record.id).Scan(pq.Array(&banner.TagIDs), &banner.FeatureID, &banner.Content, &banner.IsActive, &banner.Priority, &banner.DeletedAt, &publishedFeatureID)
+
+	if err == sql.ErrNoRows {
+		id, writeErr, err := createBannerWithID(tx, record.id, record.input, audit)
+		return BannerImportRecordActionCreated, id, writeErr, err
+	} else if err != nil {
+		return "", 0, nil, err
+	}
+
+	if mode == SkipExisting {
+		return BannerImportRecordActionSkipped, record.id, nil, nil
+	}
+
+	equal, err := bannerEqualsInput(banner, record.input)
+
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if equal && banner.DeletedAt == nil {
+		return BannerImportRecordActionUnchanged, record.id, nil, nil
+	}
+
+	// Feature and tags could be archived or deleted while the banner was in trash
+	old := &banner
+	if banner.DeletedAt != nil {
+		old = nil
+	}
+
+	fieldErrs, err := checkBannerRefs(tx, record.input, old)
+
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(fieldErrs) > 0 {
+		return "", 0, invalidBannerError(fieldErrs), nil
+	}
+
+	fieldErrs, err = checkBannerContent(tx, record.input)
+
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(fieldErrs) > 0 {
+		return "", 0, invalidBannerError(fieldErrs), nil
+	}
+	if err := lockFeatures(tx, banner.FeatureID, record.input.FeatureId); err != nil {
+		return "", 0, nil, err
+	}
+
+	writeErr, err := checkBannerUnique(tx, record.id, record.input)
+
+	if err != nil || writeErr != nil {
+		return "", 0, writeErr, err
+	}
+
+	contentJSON, err := json.Marshal(record.input.Content)
+
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	query = "UPDATE banners SET tag_ids = $1, feature_id = $2, content = $3, is_active = $4, priority = $5, deleted_at = NULL, state = 'draft' WHERE id = $6"
+	_, err = tx.Exec(query, pq.Array(record.input.TagIds), record.input.FeatureId, contentJSON, record.input.IsActive, bannerPriority(record.input), record.id)
+
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	current, err := bannerDocument(banner)
+
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	document, err := bannerInputDocument(record.input, contentJSON)
+
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	diff, err := auditDiff(current, document)
+
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	// Import brings deleted banners back, it is logged like a restore from trash
+	if banner.DeletedAt != nil {
+		err := recordBannerChange(tx, audit, auditRestore, record.id, auditFieldDiff("deleted_at", *banner.DeletedAt, nil))
+
+		if err != nil {
+			return "", 0, nil, err
+		}
+		if publishedFeatureID.Valid {
+			*restoredFeatureIDs = append(*restoredFeatureIDs, int(publishedFeatureID.Int64))
+		}
+		if len(diff) == 0 {
+			return BannerImportRecordActionUpdated, record.id, nil, nil
+		}
+	}
+	return BannerImportRecordActionUpdated, record.id, nil, recordBannerChange(tx, audit, auditUpdate, record.id, diff)
+}
+
+// Checks if stored banner already has all imported fields
+func bannerEqualsInput(banner Banner, input BannerInput) (bool, error) {
+	if banner.FeatureID != input.FeatureId || banner.IsActive != input.IsActive || banner.Priority != bannerPriority(input) {
+		return false, nil
+	}
+	if len(banner.TagIDs) != len(input.TagIds) {
+		return false, nil
+	}
+	for i, tagID := range banner.TagIDs {
+		if tagID != int64(input.TagIds[i]) {
+			return false, nil
+		}
+	}
+
+	var content map[string]interface{}
+
+	if err := json.Unmarshal(banner.Content, &content); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(content, input.Content), nil
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeNDJSONImport(t *testing.T) {
+	data := []byte(`{"id": 5, "tag_ids": [1], "feature_id": 2, "content": {"title": "a"}, "is_active": true, "revision": 3}
+
+[1, 2]
+{"tag_ids": [1], "feature_id": 2, "content": {}, "is_active": "yes"}
+`)
+
+	records, errs := decodeNDJSONImport(data)
+	assert.Empty(t, errs)
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, 1, records[0].line)
+		assert.Equal(t, 5, records[0].id)
+		assert.Empty(t, records[0].errs)
+		assert.Equal(t, 3, records[1].line)
+		assert.Equal(t, "body", records[1].errs[0].Field)
+		assert.Equal(t, 4, records[2].line)
+		assert.Equal(t, "is_active", records[2].errs[0].Field)
+	}
+}
+
+func TestDecodeCSVImport(t *testing.T) {
+	data := []byte("id,feature_id,tag_ids,content,is_active,priority\n" +
+		"5,2,\"[1,2]\",\"{\"\"title\"\": \"\"a\"\"}\",true,\n" +
+		",3,[4],{},false,1\n")
+
+	records, errs := decodeCSVImport(data)
+	assert.Empty(t, errs)
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, 2, records[0].line)
+		assert.Equal(t, 5, records[0].id)
+		assert.Empty(t, records[0].errs)
+		assert.Equal(t, []int{1, 2}, records[0].input.TagIds)
+		assert.Equal(t, 0, records[1].id)
+		assert.Equal(t, 1, bannerPriority(records[1].input))
+	}
+
+	_, errs = decodeCSVImport([]byte("id,title\n1,a\n"))
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "title", errs[0].Field)
+	}
+}
+
+func TestGetBannerExportCSV(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Date(2024, 4, 10, 12, 30, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"id", "tag_ids", "feature_id", "content", "is_active", "created_at", "updated_at", "revision", "priority", "deleted_at", "state", "published_revision", "published_at", "review_comment"}).
+		AddRow(7, "{1,2}", 3, []byte(`{"title":"a"}`), true, now, now, 2, 0, nil, "published", 1, now, nil)
+	db_mock.ExpectQuery("SELECT " + bannerColumns + " FROM banners WHERE deleted_at IS NULL AND feature_id = ANY($1::int[]) ORDER BY id").
+		WillReturnRows(rows)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner/export?format=csv&feature_id=3", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBannerExport(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+		expected := "id,feature_id,tag_ids,content,is_active,priority,revision,created_at,updated_at\n" +
+			"7,3,\"[1,2]\",\"{\"\"title\"\":\"\"a\"\"}\",true,0,2,2024-04-10T12:30:00Z,2024-04-10T12:30:00Z\n"
+		assert.Equal(t, expected, rec.Body.String())
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerImport(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	// Banner 5 already exists with the same fields
+	db_mock.ExpectQuery("SELECT tag_ids, feature_id, content, is_active, priority, deleted_at, published_feature_id FROM banners WHERE id = $1 FOR UPDATE").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"tag_ids", "feature_id", "content", "is_active", "priority", "deleted_at", "published_feature_id"}).
+			AddRow("{1}", 2, []byte(`{"title":"a"}`), true, 0, nil, nil))
+	// Banner 9 doesn't exist and is created with its id
+	db_mock.ExpectQuery("SELECT tag_ids, feature_id, content, is_active, priority, deleted_at, published_feature_id FROM banners WHERE id = $1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"tag_ids", "feature_id", "content", "is_active", "priority", "deleted_at", "published_feature_id"}))
+	expectBannerRefs(db_mock, 3, []int{4})
+	expectContentSchema(db_mock, 3, nil)
+	db_mock.ExpectExec("SELECT pg_advisory_xact_lock('banners'::regclass::oid::int, $1)").
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(3, 0, "{4}", 9).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag_id"}))
+	db_mock.ExpectExec("INSERT INTO banners (id, content, feature_id, tag_ids, is_active, priority) VALUES ($1, $2, $3, $4, $5, $6)").
+		WithArgs(9, []byte(`{}`), 3, "{4}", false, 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectBannerChange(db_mock, auditCreate, 9)
+	db_mock.ExpectExec("SELECT setval(pg_get_serial_sequence('banners', 'id'), greatest(nextval(pg_get_serial_sequence('banners', 'id')), $1))").
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectCommit()
+
+	body := `{"id": 5, "tag_ids": [1], "feature_id": 2, "content": {"title": "a"}, "is_active": true}
+{"id": 9, "tag_ids": [4], "feature_id": 3, "content": {}, "is_active": false}
+`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/banner/import", strings.NewReader(body))
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBannerImport(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var report BannerImportReport
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.True(t, report.Applied)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, 1, report.Unchanged)
+		if assert.Len(t, report.Records, 1) {
+			assert.Equal(t, 2, report.Records[0].Line)
+			assert.Equal(t, BannerImportRecordActionCreated, report.Records[0].Action)
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerImportRestoresTrashedBanner(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	// Banner 5 is in trash with the same fields, its published revision is served again after import
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT tag_ids, feature_id, content, is_active, priority, deleted_at, published_feature_id FROM banners WHERE id = $1 FOR UPDATE").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"tag_ids", "feature_id", "content", "is_active", "priority", "deleted_at", "published_feature_id"}).
+			AddRow("{1}", 2, []byte(`{"title":"a"}`), true, 0, time.Now(), 2))
+	expectBannerRefs(db_mock, 2, []int{1})
+	expectContentSchema(db_mock, 2, nil)
+	expectFeatureLock(db_mock, 2)
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(2, 0, "{1}", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag_id"}))
+	db_mock.ExpectExec("UPDATE banners SET tag_ids = $1, feature_id = $2, content = $3, is_active = $4, priority = $5, deleted_at = NULL, state = 'draft' WHERE id = $6").
+		WithArgs("{1}", 2, []byte(`{"title":"a"}`), true, 0, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Nothing but deleted_at changed, so only the restore is logged
+	expectBannerChange(db_mock, auditRestore, 5)
+	db_mock.ExpectCommit()
+	cache_mock.ExpectScan(0, "2:*", 100).SetVal([]string{"2:1"}, 0)
+	cache_mock.ExpectDel("2:1").SetVal(1)
+	cache_mock.ExpectPublish(bannerUpdatesChannel, 2).SetVal(0)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/banner/import", strings.NewReader(`{"id": 5, "tag_ids": [1], "feature_id": 2, "content": {"title": "a"}, "is_active": true}`))
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBannerImport(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var report BannerImportReport
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.True(t, report.Applied)
+		assert.Equal(t, 1, report.Updated)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerImportFailedRecordRollsBack(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	db_mock.ExpectRollback()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/banner/import?dry_run=true", strings.NewReader("feature_id,tag_ids,content,is_active\n2,[1],{},maybe\n"))
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	req.Header.Set("Content-Type", "text/csv")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBannerImport(c)) {
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+		var report BannerImportReport
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.False(t, report.Applied)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 1, report.Failed)
+		if assert.Len(t, report.Records, 1) {
+			assert.Equal(t, 2, report.Records[0].Line)
+			assert.Equal(t, http.StatusBadRequest, *report.Records[0].Status)
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerImportUnsupportedMediaType(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/banner/import", strings.NewReader("<banners/>"))
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	req.Header.Set("Content-Type", "application/xml")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBannerImport(c)) {
+		assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+	}
+}
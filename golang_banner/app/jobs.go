@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Kinds of background jobs
+const (
+	jobKindBulkDelete   = "bulk_delete"
+	jobKindSchemaReport = "schema_report"
+)
+
+// How often job worker looks for queued jobs when nobody wakes it up
+const jobPollInterval = 5 * time.Second
+
+// Worker renews lease of running job until the job ends. Job whose lease expired is claimed again by any worker
+const (
+	jobLease              = time.Minute
+	jobLeaseRenewInterval = jobLease / 3
+)
+
+// Columns of jobs table in the order scanJob expects them
+const jobColumns = "id, kind, status, params, total, processed, error, created_at, updated_at, finished_at, result"
+
+// Scans job row selected with jobColumns
+func scanJob(row rowScanner) (Job, error) {
+	var job Job
+	var params, result []byte
+	err := row.Scan(&job.Id, &job.Kind, &job.Status, &params, &job.Total, &job.Processed, &job.Error, &job.CreatedAt, &job.UpdatedAt, &job.FinishedAt, &result)
+
+	if err != nil {
+		return job, err
+	}
+	if params != nil {
+		var decoded map[string]interface{}
+
+		if err := json.Unmarshal(params, &decoded); err != nil {
+			return job, err
+		}
+		job.Params = &decoded
+	}
+	if result != nil {
+		var decoded map[string]interface{}
+
+		if err := json.Unmarshal(result, &decoded); err != nil {
+			return job, err
+		}
+		job.Result = &decoded
+	}
+	return job, nil
+}
+
+func (s *Server) GetJobsId(ctx echo.Context, id int, params GetJobsIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	job, writeErr, err := s.findJob(id)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusOK, job)
+}
+
+// Reads job by id. Used by REST and gRPC APIs
+func (s *Server) findJob(id int) (Job, *bannerWriteError, error) {
+	job, err := scanJob(s.db.QueryRow("SELECT "+jobColumns+" FROM jobs WHERE id = $1", id))
+
+	if err == sql.ErrNoRows {
+		return job, &bannerWriteError{status: http.StatusNotFound, message: "Задача не найдена"}, nil
+	}
+	return job, nil, err
+}
+
+// Database or transaction jobs are stored with
+type jobQuerier interface {
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// Stores queued job and wakes up job worker. Returns id of the job
+func (s *Server) enqueueJob(kind string, params interface{}) (int, error) {
+	id, err := insertJob(s.db, kind, params)
+
+	if err != nil {
+		return 0, err
+	}
+	s.wakeJobWorker()
+	return id, nil
+}
+
+// Stores queued job. Job stored in transaction has to be announced with wakeJobWorker after commit
+func insertJob(q jobQuerier, kind string, params interface{}) (int, error) {
+	data, err := json.Marshal(params)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var id int
+	err = q.QueryRow("INSERT INTO jobs (kind, params) VALUES ($1, $2) RETURNING id", kind, data).Scan(&id)
+	return id, err
+}
+
+// Wakes up job worker unless it is already woken up
+func (s *Server) wakeJobWorker() {
+	select {
+	case s.jobWakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Runs queued jobs one by one until ctx is done. Jobs are claimed with SKIP LOCKED, so several instances can run workers
+func (s *Server) runJobWorker(ctx context.Context) {
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for s.runNextJob(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.jobWakeup:
+		}
+	}
+}
+
+// Claims and runs the oldest queued job or job whose worker stopped renewing its lease. Returns false if there was nothing to run.
+// Reclaimed job starts over, so its progress is reset
+func (s *Server) runNextJob(ctx context.Context) bool {
+	var id int
+	var kind string
+	var params []byte
+	query := `UPDATE jobs SET status = 'running', processed = 0, locked_until = now() + make_interval(secs => $1), updated_at = now()
+	WHERE id = (SELECT id FROM jobs WHERE status = 'queued' OR (status = 'running' AND locked_until < now()) ORDER BY id FOR UPDATE SKIP LOCKED LIMIT 1)
+	RETURNING id, kind, params`
+	err := s.db.QueryRowContext(ctx, query, jobLease.Seconds()).Scan(&id, &kind, &params)
+
+	if err != nil {
+		if err != sql.ErrNoRows && ctx.Err() == nil {
+			log.Printf("claiming job failed: %s", err)
+		}
+		return false
+	}
+
+	leaseCtx, stopLease := context.WithCancel(ctx)
+	leaseDone := make(chan struct{})
+	go func() {
+		defer close(leaseDone)
+		renewJobLease(leaseCtx, s.db, id)
+	}()
+
+	switch kind {
+	case jobKindBulkDelete:
+		err = s.runBulkDelete(ctx, id, params)
+	case jobKindSchemaReport:
+		err = s.runSchemaReport(ctx, id, params)
+	default:
+		err = fmt.Errorf("unknown job kind %q", kind)
+	}
+
+	stopLease()
+	<-leaseDone
+
+	if err := finishJob(s.db, id, err); err != nil {
+		log.Printf("finishing job %d failed: %s", id, err)
+	}
+	return true
+}
+
+// Extends lease of running job every jobLeaseRenewInterval until ctx is done
+func renewJobLease(ctx context.Context, db *sql.DB, id int) {
+	ticker := time.NewTicker(jobLeaseRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		query := "UPDATE jobs SET locked_until = now() + make_interval(secs => $2) WHERE id = $1 AND status = 'running'"
+		_, err := db.ExecContext(ctx, query, id, jobLease.Seconds())
+
+		if err != nil && ctx.Err() == nil {
+			log.Printf("renewing lease of job %d failed: %s", id, err)
+		}
+	}
+}
+
+// Marks job as succeeded or, if jobErr is set, as failed
+func finishJob(db *sql.DB, id int, jobErr error) error {
+	if jobErr != nil {
+		query := "UPDATE jobs SET status = 'failed', error = $2, locked_until = NULL, updated_at = now(), finished_at = now() WHERE id = $1"
+		_, err := db.Exec(query, id, jobErr.Error())
+		return err
+	}
+	query := "UPDATE jobs SET status = 'succeeded', locked_until = NULL, updated_at = now(), finished_at = now() WHERE id = $1"
+	_, err := db.Exec(query, id)
+	return err
+}
+
+// Stores number of objects job has to process
+func setJobTotal(db *sql.DB, id int, total int) error {
+	_, err := db.Exec("UPDATE jobs SET total = $2, updated_at = now() WHERE id = $1", id, total)
+	return err
+}
+
+// Adds processed objects to job progress
+func addJobProgress(db *sql.DB, id int, processed int) error {
+	_, err := db.Exec("UPDATE jobs SET processed = processed + $2, updated_at = now() WHERE id = $1", id, processed)
+	return err
+}
+
+// Stores result of job, GET /jobs/{id} returns it as is
+func setJobResult(db *sql.DB, id int, result interface{}) error {
+	data, err := json.Marshal(result)
+
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("UPDATE jobs SET result = $2, updated_at = now() WHERE id = $1", id, data)
+	return err
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteBannerEnqueuesJob(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	// Job keeps audit source of the request, banners it deletes are logged on its behalf
+	sum := sha256.Sum256([]byte("IGOTTHEPOWER!"))
+	jobParams := fmt.Sprintf(`{"feature_id":2,"actor":"admin:%s","request_id":"req-1","source_ip":"192.0.2.1"}`, hex.EncodeToString(sum[:8]))
+	db_mock.ExpectQuery("INSERT INTO jobs (kind, params) VALUES ($1, $2) RETURNING id").
+		WithArgs(jobKindBulkDelete, []byte(jobParams)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+
+	for _, test := range []struct {
+		url  string
+		code int
+	}{
+		{"/banner?feature_id=2", http.StatusAccepted},
+		{"/banner", http.StatusBadRequest},
+		{"/banner?feature_id=0", http.StatusBadRequest},
+		{"/banner?feature_id=2&tag_id=-1", http.StatusBadRequest},
+	} {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, test.url, nil)
+		req.Header.Set("token", "IGOTTHEPOWER!")
+		req.Header.Set(echo.HeaderXRequestID, "req-1")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, wrapper.DeleteBanner(c)) {
+			assert.Equal(t, test.code, rec.Code)
+		}
+		if test.code == http.StatusAccepted {
+			assert.Equal(t, "/jobs/11", rec.Header().Get("Location"))
+			assert.JSONEq(t, `{"job_id": 11}`, rec.Body.String())
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestBulkDeleteFilterBuilder(t *testing.T) {
+	featureID, tagID := 2, 4
+	query, args := bulkDeleteFilterBuilder(bulkDeleteParams{FeatureID: &featureID, TagID: &tagID})
+
+	// Banner whose published revision still has the feature or tag is deleted too
+	assert.Equal(t, " WHERE deleted_at IS NULL AND (feature_id = $1 OR published_feature_id = $1)"+
+		" AND (tag_ids @> ARRAY[$2::int] OR published_tag_ids @> ARRAY[$2::int])", query)
+	assert.Equal(t, []interface{}{2, 4}, args)
+}
+
+func TestGetJobsId(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "kind", "status", "params", "total", "processed", "error", "created_at", "updated_at", "finished_at", "result"}).
+		AddRow(11, jobKindBulkDelete, "running", []byte(`{"feature_id": 2}`), 1200, 500, nil, now, now, nil, nil)
+	db_mock.ExpectQuery("SELECT " + jobColumns + " FROM jobs WHERE id = $1").
+		WithArgs(11).
+		WillReturnRows(rows)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/jobs/11", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("11")
+
+	if assert.NoError(t, wrapper.GetJobsId(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var job Job
+		err := json.Unmarshal(rec.Body.Bytes(), &job)
+
+		if err != nil {
+			t.Fatalf("Error occcured: %s", err.Error())
+		}
+
+		assert.Equal(t, JobStatusRunning, job.Status)
+		assert.Equal(t, 1200, job.Total)
+		assert.Equal(t, 500, job.Processed)
+		assert.Equal(t, map[string]interface{}{"feature_id": float64(2)}, *job.Params)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRunBulkDelete(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+
+	db_mock.ExpectQuery("SELECT count(*) FROM banners WHERE deleted_at IS NULL AND (tag_ids @> ARRAY[$1::int] OR published_tag_ids @> ARRAY[$1::int])").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	db_mock.ExpectExec("UPDATE jobs SET total = $2, updated_at = now() WHERE id = $1").
+		WithArgs(11, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	query := "UPDATE banners SET deleted_at = now() WHERE id IN (SELECT id FROM banners WHERE deleted_at IS NULL AND (tag_ids @> ARRAY[$1::int] OR published_tag_ids @> ARRAY[$1::int]) ORDER BY id LIMIT 500) RETURNING id, deleted_at, published_feature_id"
+	now := time.Now()
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery(query).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at", "published_feature_id"}).
+			AddRow(3, now, 2).
+			AddRow(5, now, 2).
+			AddRow(8, now, nil))
+	// Each banner is logged to audit on behalf of the job creator
+	for _, id := range []int{3, 5, 8} {
+		db_mock.ExpectExec("INSERT INTO audit_log (actor, action, banner_id, diff, request_id, source_ip) VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''))").
+			WithArgs("admin:0011223344556677", auditDelete, id, sqlmock.AnyArg(), "req-1", "10.0.0.7").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// One deleted event per banner goes to webhook outbox
+		db_mock.ExpectExec("INSERT INTO banner_events (type, banner_id, changes) SELECT unnest($1::text[]), $2, $3").
+			WithArgs(pq.Array([]string{eventDeleted}), id, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	db_mock.ExpectCommit()
+	db_mock.ExpectExec("UPDATE jobs SET processed = processed + $2, updated_at = now() WHERE id = $1").
+		WithArgs(11, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	cache_mock.ExpectScan(0, "2:*", 100).SetVal([]string{"2:4", "2:4,5"}, 0)
+	cache_mock.ExpectDel("2:4", "2:4,5").SetVal(2)
+	cache_mock.ExpectPublish(bannerUpdatesChannel, 2).SetVal(0)
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery(query).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at", "published_feature_id"}))
+	db_mock.ExpectCommit()
+
+	assert.NoError(t, server.runBulkDelete(context.Background(), 11, []byte(`{"tag_id": 4, "actor": "admin:0011223344556677", "request_id": "req-1", "source_ip": "10.0.0.7"}`)))
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRunNextJob(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+
+	// Claim picks queued jobs as well as running ones whose lease expired
+	db_mock.ExpectQuery(`UPDATE jobs SET status = 'running', processed = 0, locked_until = now() + make_interval(secs => $1), updated_at = now()
+	WHERE id = (SELECT id FROM jobs WHERE status = 'queued' OR (status = 'running' AND locked_until < now()) ORDER BY id FOR UPDATE SKIP LOCKED LIMIT 1)
+	RETURNING id, kind, params`).
+		WithArgs(jobLease.Seconds()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "kind", "params"}).AddRow(5, "unknown", []byte(`{}`)))
+	db_mock.ExpectExec("UPDATE jobs SET status = 'failed', error = $2, locked_until = NULL, updated_at = now(), finished_at = now() WHERE id = $1").
+		WithArgs(5, `unknown job kind "unknown"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.True(t, server.runNextJob(context.Background()))
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
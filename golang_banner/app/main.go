@@ -3,10 +3,16 @@ package main
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"github.com/go-redis/redis/v8"
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
 	_ "github.com/lib/pq"
+	"log"
+	"net"
+	"net/http"
 	"os"
+	"strconv"
 )
 
 func main() {
@@ -19,23 +25,93 @@ func main() {
 	
 	defer db.Close()
 	
+	migrations, err := loadMigrations(migrationFiles)
+	
+	if err != nil {
+		panic(err)
+	}
+	
+	// "bin migrate [up | down [steps] | version]" manages schema and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrateCommand(db, migrations, os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	
+	migrateOnStart, _ := strconv.ParseBool(os.Getenv("MIGRATE_ON_START"))
+	if migrateOnStart {
+		applied, err := migrateUp(db, migrations)
+		
+		if err != nil {
+			panic(err)
+		}
+		for _, m := range applied {
+			log.Printf("applied migration %d_%s", m.version, m.name)
+		}
+	}
+	
 	cache := redis.NewClient(&redis.Options{
 		Addr:     os.Getenv("REDIS_URL"),
 		Password: "",
 		DB:       0,
 	})
+	requireIfMatch, _ := strconv.ParseBool(os.Getenv("REQUIRE_IF_MATCH"))
 	ctx := context.Background()
-	var e = echo.New()
 	server := &Server{
 		tokens: map[string]string{
 			"IGOTTHEPOWER!": "admin",
 			"IMACREEP":      "user",
 		},
-		db:    db,
-		cache: cache,
-		ctx:   ctx,
+		db:             db,
+		cache:          cache,
+		ctx:            ctx,
+		requireIfMatch: requireIfMatch,
+		jobWakeup:      make(chan struct{}, 1),
+		schemaVersion:  latestMigrationVersion(migrations),
+		webhookClient:  &http.Client{Timeout: webhookTimeout},
+		streams:        newBannerStreamHub(),
+	}
+	
+	// Tokens are configured here, so changes of their set are logged to audit when the service starts
+	if err := server.auditTokenSet(); err != nil {
+		log.Printf("failed to log tokens to audit: %s", err)
 	}
 	
+	go server.runJobWorker(ctx)
+	go server.runWebhookDispatcher(ctx)
+	go server.runBannerStreamHub(ctx)
+	go runTrashPurger(ctx, db, durationFromEnv("TRASH_RETENTION", defaultTrashRetention), durationFromEnv("TRASH_PURGE_INTERVAL", defaultTrashPurgeInterval))
+	
+	grpcAddr := os.Getenv("GRPC_ADDR")
+	if grpcAddr == "" {
+		grpcAddr = ":9090"
+	}
+	listener, err := net.Listen("tcp", grpcAddr)
+	
+	if err != nil {
+		panic(err)
+	}
+	
+	go func() {
+		if err := newGRPCServer(server).Serve(listener); err != nil {
+			log.Printf("gRPC server stopped: %s", err)
+		}
+	}()
+	
+	httpAddr := os.Getenv("HTTP_ADDR")
+	if httpAddr == "" {
+		httpAddr = ":8080"
+	}
+	newEcho(server).Start(httpAddr)
+}
+
+// Router of REST API
+func newEcho(server *Server) *echo.Echo {
+	e := echo.New()
+	// Request id is recorded in audit log, requests without X-Request-Id get a generated one
+	e.Use(middleware.RequestID())
 	RegisterHandlers(e, server)
-	e.Start(":8080")
+	return e
 }
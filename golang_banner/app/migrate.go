@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Advisory lock key that serializes migrations of several instances started at once
+const migrationLockKey = 7039
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Versioned schema change. Migration files are named <version>_<name>.up.sql and <version>_<name>.down.sql
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+// Reads migrations from migrations directory of fsys. Returns them sorted by version
+func loadMigrations(fsys fs.FS) ([]migration, error) {
+	entries, err := fs.ReadDir(fsys, "migrations")
+
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*migration{}
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file %s", entry.Name())
+		}
+
+		data, err := fs.ReadFile(fsys, "migrations/"+entry.Name())
+
+		if err != nil {
+			return nil, err
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: match[2]}
+			byVersion[version] = m
+		} else if m.name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.up = string(data)
+		} else {
+			m.down = string(data)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" || m.down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both up and down files", m.version, m.name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
+
+// Returns version of the latest migration, zero if there are none
+func latestMigrationVersion(migrations []migration) int {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].version
+}
+
+// Starts migration transaction. Transaction holds migration lock and sees schema_migrations table
+func beginMigration(db *sql.DB) (*sql.Tx, error) {
+	tx, err := db.Begin()
+
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1)", migrationLockKey); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	query := `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT now()
+	)`
+	if _, err := tx.Exec(query); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Applies all pending migrations in one transaction. Returns applied migrations
+func migrateUp(db *sql.DB, migrations []migration) ([]migration, error) {
+	tx, err := beginMigration(db)
+
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Migration 0 adopts databases created by init.sql, so a database without migrations starts below it
+	var current int
+	if err := tx.QueryRow("SELECT coalesce(max(version), -1) FROM schema_migrations").Scan(&current); err != nil {
+		return nil, err
+	}
+
+	var applied []migration
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		if _, err := tx.Exec(m.up); err != nil {
+			return nil, fmt.Errorf("migration %d_%s: %w", m.version, m.name, err)
+		}
+		if _, err := tx.Exec("INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", m.version, m.name); err != nil {
+			return nil, err
+		}
+		applied = append(applied, m)
+	}
+	return applied, tx.Commit()
+}
+
+// Reverts the last steps applied migrations in one transaction. Returns reverted migrations
+func migrateDown(db *sql.DB, migrations []migration, steps int) ([]migration, error) {
+	tx, err := beginMigration(db)
+
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query("SELECT version FROM schema_migrations ORDER BY version DESC LIMIT $1", steps)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []int
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]migration{}
+	for _, m := range migrations {
+		byVersion[m.version] = m
+	}
+
+	var reverted []migration
+	for _, version := range versions {
+		m, ok := byVersion[version]
+		if !ok {
+			return nil, fmt.Errorf("migration %d is applied but not embedded in this build", version)
+		}
+		if _, err := tx.Exec(m.down); err != nil {
+			return nil, fmt.Errorf("migration %d_%s: %w", m.version, m.name, err)
+		}
+		if _, err := tx.Exec("DELETE FROM schema_migrations WHERE version = $1", m.version); err != nil {
+			return nil, err
+		}
+		reverted = append(reverted, m)
+	}
+	return reverted, tx.Commit()
+}
+
+// Returns version of the latest applied migration, zero for a database without migrations
+func currentSchemaVersion(db *sql.DB) (int, error) {
+	var version int
+	err := db.QueryRow("SELECT coalesce(max(version), 0) FROM schema_migrations").Scan(&version)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "42P01" {
+		return 0, nil
+	}
+	return version, err
+}
+
+// Runs migrate subcommand: migrate [up | down [steps] | version]
+func runMigrateCommand(db *sql.DB, migrations []migration, args []string) error {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "up":
+		applied, err := migrateUp(db, migrations)
+
+		if err != nil {
+			return err
+		}
+		for _, m := range applied {
+			fmt.Printf("applied %d_%s\n", m.version, m.name)
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			steps, err = strconv.Atoi(args[1])
+
+			if err != nil || steps < 1 {
+				return fmt.Errorf("steps must be a positive integer, got %s", args[1])
+			}
+		}
+
+		reverted, err := migrateDown(db, migrations, steps)
+
+		if err != nil {
+			return err
+		}
+		for _, m := range reverted {
+			fmt.Printf("reverted %d_%s\n", m.version, m.name)
+		}
+	case "version":
+		version, err := currentSchemaVersion(db)
+
+		if err != nil {
+			return err
+		}
+		fmt.Printf("schema version %d, latest migration %d\n", version, latestMigrationVersion(migrations))
+	default:
+		return fmt.Errorf("unknown migrate command %s, expected up, down or version", command)
+	}
+	return nil
+}
+
+func (s *Server) GetReadyz(ctx echo.Context) error {
+	readiness := s.readiness(ctx.Request().Context())
+
+	if !readiness.Ready {
+		return ctx.JSON(http.StatusServiceUnavailable, readiness)
+	}
+	return ctx.JSON(http.StatusOK, readiness)
+}
+
+// Checks schema version and connections to postgres and redis. Used by REST and gRPC APIs
+func (s *Server) readiness(ctx context.Context) Readiness {
+	readiness := Readiness{ExpectedSchemaVersion: s.schemaVersion}
+	var errs []string
+
+	version, err := currentSchemaVersion(s.db)
+
+	if err != nil {
+		errs = append(errs, "postgres: "+err.Error())
+	} else if version < s.schemaVersion {
+		errs = append(errs, fmt.Sprintf("schema version %d is older than %d, run migrate up", version, s.schemaVersion))
+	}
+	readiness.SchemaVersion = version
+
+	if err := s.cache.Ping(ctx).Err(); err != nil {
+		errs = append(errs, "redis: "+err.Error())
+	}
+
+	if len(errs) > 0 {
+		readiness.Errors = &errs
+		return readiness
+	}
+	readiness.Ready = true
+	return readiness
+}
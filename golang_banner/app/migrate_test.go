@@ -0,0 +1,234 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := loadMigrations(migrationFiles)
+	assert.NoError(t, err)
+	// Adoption of init.sql databases goes before the first migration
+	if assert.Greater(t, len(migrations), 1) {
+		assert.Equal(t, 0, migrations[0].version)
+		assert.Equal(t, "adopt_init_sql", migrations[0].name)
+		assert.Equal(t, 1, migrations[1].version)
+		assert.Equal(t, "create_banners", migrations[1].name)
+	}
+	for i := 1; i < len(migrations); i++ {
+		assert.Less(t, migrations[i-1].version, migrations[i].version)
+	}
+
+	_, err = loadMigrations(fstest.MapFS{
+		"migrations/0001_init.up.sql": {Data: []byte("CREATE TABLE t (id INTEGER)")},
+	})
+	assert.Error(t, err)
+
+	_, err = loadMigrations(fstest.MapFS{
+		"migrations/init.sql": {Data: []byte("CREATE TABLE t (id INTEGER)")},
+	})
+	assert.Error(t, err)
+}
+
+func TestMigrateUpAppliesPending(t *testing.T) {
+	db, db_mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	migrations := []migration{
+		{version: 1, name: "first", up: "CREATE TABLE a (id INTEGER)", down: "DROP TABLE a"},
+		{version: 2, name: "second", up: "CREATE TABLE b (id INTEGER)", down: "DROP TABLE b"},
+	}
+
+	db_mock.ExpectBegin()
+	db_mock.ExpectExec("SELECT pg_advisory_xact_lock($1)").
+		WithArgs(migrationLockKey).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectExec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT now()
+	)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectQuery("SELECT coalesce(max(version), -1) FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	db_mock.ExpectExec("CREATE TABLE b (id INTEGER)").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectExec("INSERT INTO schema_migrations (version, name) VALUES ($1, $2)").
+		WithArgs(2, "second").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	db_mock.ExpectCommit()
+
+	applied, err := migrateUp(db, migrations)
+	assert.NoError(t, err)
+	if assert.Len(t, applied, 1) {
+		assert.Equal(t, 2, applied[0].version)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetReadyzOldSchema(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+	server.schemaVersion = 2
+
+	db_mock.ExpectQuery("SELECT coalesce(max(version), 0) FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	cache_mock.ExpectPing().SetVal("PONG")
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, server.GetReadyz(c)) {
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+		var readiness Readiness
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &readiness))
+		assert.False(t, readiness.Ready)
+		assert.Equal(t, 1, readiness.SchemaVersion)
+		assert.Equal(t, 2, readiness.ExpectedSchemaVersion)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetReadyz(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+	server.schemaVersion = 2
+
+	db_mock.ExpectQuery("SELECT coalesce(max(version), 0) FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+	cache_mock.ExpectPing().SetVal("PONG")
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, server.GetReadyz(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+}
+
+// Schema the service created with init.sql before migrations were added
+const initSQLSchema = `CREATE TABLE banners (
+    id SERIAL PRIMARY KEY,
+    tag_ids INTEGER[],
+    feature_id INTEGER,
+    content JSONB,
+    is_active BOOLEAN,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE OR REPLACE FUNCTION update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+  NEW.updated_at = now();
+  RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_features_updated_at
+BEFORE UPDATE ON banners
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+INSERT INTO banners (content, feature_id, tag_ids, is_active) VALUES ('{"key" : "value"}'::jsonb, 2, ARRAY[2, 3], false)`
+
+// Creates empty database on the server of MIGRATE_DATABASE_URL and drops it when test ends
+func openMigrateTestDB(t *testing.T) *sql.DB {
+	databaseURL := os.Getenv("MIGRATE_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("MIGRATE_DATABASE_URL is not set")
+	}
+
+	admin, err := sql.Open("postgres", databaseURL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	name := fmt.Sprintf("banner_migrate_test_%d", time.Now().UnixNano())
+	if _, err := admin.Exec("CREATE DATABASE " + name); err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(databaseURL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.Path = "/" + name
+
+	db, err := sql.Open("postgres", u.String())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		if _, err := admin.Exec("DROP DATABASE IF EXISTS " + name); err != nil {
+			t.Error(err)
+		}
+	})
+	return db
+}
+
+func TestMigrateUpFromInitSQL(t *testing.T) {
+	db := openMigrateTestDB(t)
+
+	if _, err := db.Exec(initSQLSchema); err != nil {
+		t.Fatal(err)
+	}
+
+	migrations, err := loadMigrations(migrationFiles)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applied, err := migrateUp(db, migrations)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, applied, len(migrations))
+
+	// Banner of init.sql is kept and published, and its updates bump revision
+	var revision int
+	var state string
+	err = db.QueryRow("SELECT revision, state FROM banners WHERE feature_id = 2").Scan(&revision, &state)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, revision)
+		assert.Equal(t, "published", state)
+	}
+
+	err = db.QueryRow("UPDATE banners SET is_active = true WHERE feature_id = 2 RETURNING revision").Scan(&revision)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, revision)
+	}
+}
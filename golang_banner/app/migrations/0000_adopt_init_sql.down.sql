@@ -0,0 +1,2 @@
+-- Columns belong to banners since 0001, its down migration drops them with the table
+SELECT 1;
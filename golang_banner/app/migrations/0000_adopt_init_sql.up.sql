@@ -0,0 +1,6 @@
+-- Databases created by the old init.sql have banners without revision, priority and deleted_at.
+-- 0001 skips their table and indexes it on these columns, so they are added first. Fresh databases have no table yet
+ALTER TABLE IF EXISTS banners
+    ADD COLUMN IF NOT EXISTS revision INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS priority INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
@@ -0,0 +1,38 @@
+-- IF NOT EXISTS lets databases created by the old init.sql adopt migrations
+CREATE TABLE IF NOT EXISTS banners (
+    id SERIAL PRIMARY KEY,
+    tag_ids INTEGER[],
+    feature_id INTEGER,
+    content JSONB,
+    is_active BOOLEAN,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    revision INTEGER NOT NULL DEFAULT 1,
+    priority INTEGER NOT NULL DEFAULT 0,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS banners_created_at_id_idx ON banners (created_at, id);
+CREATE INDEX IF NOT EXISTS banners_updated_at_id_idx ON banners (updated_at, id);
+CREATE INDEX IF NOT EXISTS banners_feature_id_id_idx ON banners (feature_id, id);
+CREATE INDEX IF NOT EXISTS banners_content_fts_idx ON banners USING GIN (to_tsvector('simple', content));
+CREATE INDEX IF NOT EXISTS banners_content_path_idx ON banners USING GIN (content jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS banners_deleted_at_idx ON banners (deleted_at, id) WHERE deleted_at IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+  NEW.updated_at = now();
+  NEW.revision = OLD.revision + 1;
+  RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE TRIGGER update_features_updated_at
+BEFORE UPDATE ON banners
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+INSERT INTO banners (content, feature_id, tag_ids, is_active)
+SELECT '{"key" : "value"}'::jsonb, 2, ARRAY[2, 3], false
+WHERE NOT EXISTS (SELECT 1 FROM banners);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS banners_tag_ids_idx;
@@ -0,0 +1,2 @@
+-- Tag lookups use && and @>, which GIN answers without scanning the table
+CREATE INDEX IF NOT EXISTS banners_tag_ids_idx ON banners USING GIN (tag_ids) WHERE deleted_at IS NULL;
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS banners_tag_ids_fkey ON banners;
+DROP FUNCTION IF EXISTS check_banner_tags();
+ALTER TABLE banners DROP CONSTRAINT IF EXISTS banners_feature_id_fkey;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS features;
+DROP FUNCTION IF EXISTS check_tag_unused();
+DROP FUNCTION IF EXISTS touch_updated_at();
@@ -0,0 +1,85 @@
+CREATE TABLE IF NOT EXISTS features (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    owner TEXT NOT NULL DEFAULT '',
+    archived BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    owner TEXT NOT NULL DEFAULT '',
+    archived BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Features and tags of existing banners get placeholder names, so foreign keys hold for old data
+INSERT INTO features (id, name)
+SELECT DISTINCT feature_id, 'feature ' || feature_id FROM banners WHERE feature_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO tags (id, name)
+SELECT DISTINCT t, 'tag ' || t FROM banners, unnest(tag_ids) AS t
+ON CONFLICT DO NOTHING;
+
+SELECT setval(pg_get_serial_sequence('features', 'id'), coalesce(max(id), 1), max(id) IS NOT NULL) FROM features;
+SELECT setval(pg_get_serial_sequence('tags', 'id'), coalesce(max(id), 1), max(id) IS NOT NULL) FROM tags;
+
+CREATE OR REPLACE FUNCTION touch_updated_at()
+RETURNS TRIGGER AS $$
+BEGIN
+  NEW.updated_at = now();
+  RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE TRIGGER touch_features_updated_at
+BEFORE UPDATE ON features
+FOR EACH ROW
+EXECUTE PROCEDURE touch_updated_at();
+
+CREATE OR REPLACE TRIGGER touch_tags_updated_at
+BEFORE UPDATE ON tags
+FOR EACH ROW
+EXECUTE PROCEDURE touch_updated_at();
+
+ALTER TABLE banners ADD CONSTRAINT banners_feature_id_fkey FOREIGN KEY (feature_id) REFERENCES features (id);
+
+-- Arrays can't have foreign keys, so both directions of tag_ids -> tags are checked by triggers
+CREATE OR REPLACE FUNCTION check_banner_tags()
+RETURNS TRIGGER AS $$
+DECLARE
+  missing INTEGER;
+BEGIN
+  SELECT t INTO missing FROM unnest(NEW.tag_ids) AS t WHERE NOT EXISTS (SELECT 1 FROM tags WHERE id = t) LIMIT 1;
+  IF missing IS NOT NULL THEN
+    RAISE EXCEPTION 'tag % does not exist', missing USING ERRCODE = 'foreign_key_violation';
+  END IF;
+  RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE TRIGGER banners_tag_ids_fkey
+BEFORE INSERT OR UPDATE OF tag_ids ON banners
+FOR EACH ROW
+EXECUTE PROCEDURE check_banner_tags();
+
+CREATE OR REPLACE FUNCTION check_tag_unused()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM banners WHERE tag_ids @> ARRAY[OLD.id]) THEN
+    RAISE EXCEPTION 'tag % is used by banners', OLD.id USING ERRCODE = 'foreign_key_violation';
+  END IF;
+  RETURN OLD;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE TRIGGER tags_banners_fkey
+BEFORE DELETE ON tags
+FOR EACH ROW
+EXECUTE PROCEDURE check_tag_unused();
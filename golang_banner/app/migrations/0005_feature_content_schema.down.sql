@@ -0,0 +1,2 @@
+ALTER TABLE jobs DROP COLUMN IF EXISTS result;
+ALTER TABLE features DROP COLUMN IF EXISTS content_schema;
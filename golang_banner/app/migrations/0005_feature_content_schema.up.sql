@@ -0,0 +1,2 @@
+ALTER TABLE features ADD COLUMN IF NOT EXISTS content_schema JSONB;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS result JSONB;
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS banners_published_tag_ids_idx;
+
+ALTER TABLE banners
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS published_revision,
+    DROP COLUMN IF EXISTS published_priority,
+    DROP COLUMN IF EXISTS published_is_active,
+    DROP COLUMN IF EXISTS published_content,
+    DROP COLUMN IF EXISTS published_feature_id,
+    DROP COLUMN IF EXISTS published_tag_ids,
+    DROP COLUMN IF EXISTS review_comment,
+    DROP COLUMN IF EXISTS state;
@@ -0,0 +1,30 @@
+-- published_* columns keep the revision users get, while tag_ids, feature_id, content, is_active and priority keep the edited one
+ALTER TABLE banners
+    ADD COLUMN IF NOT EXISTS state TEXT NOT NULL DEFAULT 'draft'
+        CHECK (state IN ('draft', 'pending_review', 'approved', 'published', 'archived')),
+    ADD COLUMN IF NOT EXISTS review_comment TEXT,
+    ADD COLUMN IF NOT EXISTS published_tag_ids INTEGER[],
+    ADD COLUMN IF NOT EXISTS published_feature_id INTEGER,
+    ADD COLUMN IF NOT EXISTS published_content JSONB,
+    ADD COLUMN IF NOT EXISTS published_is_active BOOLEAN,
+    ADD COLUMN IF NOT EXISTS published_priority INTEGER,
+    ADD COLUMN IF NOT EXISTS published_revision INTEGER,
+    ADD COLUMN IF NOT EXISTS published_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS banners_published_tag_ids_idx ON banners USING GIN (published_tag_ids) WHERE deleted_at IS NULL;
+
+-- Users already get existing banners, so they are published as they are. Trigger is disabled to keep their revisions and ETags
+ALTER TABLE banners DISABLE TRIGGER update_features_updated_at;
+
+UPDATE banners
+SET state = 'published',
+    published_tag_ids = tag_ids,
+    published_feature_id = feature_id,
+    published_content = content,
+    published_is_active = is_active,
+    published_priority = priority,
+    published_revision = revision,
+    published_at = updated_at
+WHERE state = 'draft' AND published_revision IS NULL;
+
+ALTER TABLE banners ENABLE TRIGGER update_features_updated_at;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    -- No foreign key, entries outlive banners purged from trash
+    banner_id INTEGER,
+    diff JSONB NOT NULL DEFAULT '{}',
+    request_id TEXT,
+    source_ip TEXT
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_banner_id_idx ON audit_log (banner_id, id);
+CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor, id);
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at);
+
+-- Audit log is append-only. Triggers reject changes and removal of entries, they fire for the table owner too,
+-- though the owner can still disable them with ALTER TABLE
+CREATE OR REPLACE FUNCTION audit_log_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_log is append-only, % is not allowed', TG_OP;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE TRIGGER audit_log_no_update_delete
+BEFORE UPDATE OR DELETE ON audit_log
+FOR EACH ROW
+EXECUTE PROCEDURE audit_log_append_only();
+
+CREATE OR REPLACE TRIGGER audit_log_no_truncate
+BEFORE TRUNCATE ON audit_log
+FOR EACH STATEMENT
+EXECUTE PROCEDURE audit_log_append_only();
+
+-- Only keeps other roles from being granted these through PUBLIC, the owner keeps its privileges
+REVOKE UPDATE, DELETE, TRUNCATE ON audit_log FROM PUBLIC;
@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS webhooks (
+    id SERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    events TEXT[] NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE OR REPLACE TRIGGER touch_webhooks_updated_at
+BEFORE UPDATE ON webhooks
+FOR EACH ROW
+EXECUTE PROCEDURE touch_updated_at();
+
+-- Transactional outbox. Events are written in the transaction of the banner change,
+-- so they are stored if and only if the change is
+CREATE TABLE IF NOT EXISTS banner_events (
+    id BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    banner_id INTEGER NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMP NOT NULL DEFAULT now(),
+    -- Set when deliveries of the event are created for subscribed webhooks
+    dispatched_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS banner_events_undispatched_idx ON banner_events (id) WHERE dispatched_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id INTEGER NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id BIGINT NOT NULL REFERENCES banner_events (id),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT now(),
+    last_status INTEGER,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    delivered_at TIMESTAMP,
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_dead_idx ON webhook_deliveries (webhook_id, id) WHERE status = 'dead';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS jobs_running_locked_until_idx;
+
+ALTER TABLE jobs DROP COLUMN IF EXISTS locked_until;
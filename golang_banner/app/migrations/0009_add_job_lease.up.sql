@@ -0,0 +1,4 @@
+-- Running jobs hold a lease their worker keeps renewing. Jobs whose lease expired lost their worker and are claimed again
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS jobs_running_locked_until_idx ON jobs (locked_until) WHERE status = 'running';
@@ -0,0 +1,9 @@
+CREATE OR REPLACE FUNCTION check_tag_unused()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM banners WHERE tag_ids @> ARRAY[OLD.id]) THEN
+    RAISE EXCEPTION 'tag % is used by banners', OLD.id USING ERRCODE = 'foreign_key_violation';
+  END IF;
+  RETURN OLD;
+END;
+$$ language 'plpgsql';
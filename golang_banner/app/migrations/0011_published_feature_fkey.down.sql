@@ -0,0 +1 @@
+ALTER TABLE banners DROP CONSTRAINT IF EXISTS banners_published_feature_id_fkey;
@@ -0,0 +1,7 @@
+-- Published revision keeps its own feature, so a feature the banner had before an unpublished edit is still used.
+-- Features deleted before the key existed get placeholder names like in 0004
+INSERT INTO features (id, name)
+SELECT DISTINCT published_feature_id, 'feature ' || published_feature_id FROM banners WHERE published_feature_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE banners ADD CONSTRAINT banners_published_feature_id_fkey FOREIGN KEY (published_feature_id) REFERENCES features (id);
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Settings of webhook delivery. Attempt n waits webhookBaseBackoff * 2^(n-1), but not longer than webhookMaxBackoff
+const (
+	webhookPollInterval = time.Second
+	webhookTimeout      = 10 * time.Second
+	webhookMaxAttempts  = 10
+	webhookBaseBackoff  = 10 * time.Second
+	webhookMaxBackoff   = time.Hour
+	// Events turned into deliveries per query
+	webhookEventBatch = 100
+)
+
+// Claimed delivery is not picked again until lease passes, so a delivery of a crashed instance is retried after it
+const webhookLease = 2 * webhookTimeout
+
+// Creates deliveries of undispatched events for active webhooks subscribed to them and marks events dispatched,
+// all in one statement, so an event is either fully dispatched or stays in outbox
+const dispatchBannerEventsQuery = `WITH events AS (
+	SELECT id, type FROM banner_events WHERE dispatched_at IS NULL ORDER BY id FOR UPDATE SKIP LOCKED LIMIT $1
+), deliveries AS (
+	INSERT INTO webhook_deliveries (webhook_id, event_id)
+	SELECT w.id, e.id FROM events e JOIN webhooks w ON w.active AND e.type = ANY(w.events)
+	ON CONFLICT (webhook_id, event_id) DO NOTHING
+)
+UPDATE banner_events SET dispatched_at = now() WHERE id IN (SELECT id FROM events)`
+
+// Claims the most overdue pending delivery, counts the attempt and moves next attempt by lease
+const claimWebhookDeliveryQuery = `UPDATE webhook_deliveries d SET attempts = d.attempts + 1, next_attempt_at = now() + make_interval(secs => $1)
+FROM webhooks w, banner_events e
+WHERE d.id = (SELECT id FROM webhook_deliveries WHERE status = 'pending' AND next_attempt_at <= now() ORDER BY next_attempt_at, id FOR UPDATE SKIP LOCKED LIMIT 1)
+AND w.id = d.webhook_id AND e.id = d.event_id
+RETURNING d.id, d.attempts, w.url, w.secret, e.id, e.type, e.banner_id, e.changes, e.occurred_at`
+
+// Writes banner change to audit log and webhook outbox inside tx, so both are stored if and only if the change is
+func recordBannerChange(tx *sql.Tx, source auditSource, action string, bannerID int, diff map[string]AuditChange) error {
+	if err := appendAudit(tx, source, action, bannerID, diff); err != nil {
+		return err
+	}
+	return appendBannerEvents(tx, action, bannerID, diff)
+}
+
+// Stores webhook events of banner change in outbox
+func appendBannerEvents(tx *sql.Tx, action string, bannerID int, diff map[string]AuditChange) error {
+	changes, err := json.Marshal(diff)
+
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO banner_events (type, banner_id, changes) SELECT unnest($1::text[]), $2, $3"
+	_, err = tx.Exec(query, pq.Array(bannerEventTypes(action, diff)), bannerID, changes)
+	return err
+}
+
+// Maps audit action to webhook events. Any change other than create and delete is an update,
+// publish that turns banner on for users is also an activation
+func bannerEventTypes(action string, diff map[string]AuditChange) []string {
+	switch action {
+	case auditCreate:
+		return []string{eventCreated}
+	case auditDelete:
+		return []string{eventDeleted}
+	}
+
+	events := []string{eventUpdated}
+	if change, ok := diff["published_is_active"]; ok && change.After != nil && *change.After == true &&
+		(change.Before == nil || *change.Before == false) {
+		events = append(events, eventActivated)
+	}
+	return events
+}
+
+// Dispatches outbox events and delivers due webhooks until ctx is done. Rows are claimed with SKIP LOCKED,
+// so several instances can run dispatchers
+func (s *Server) runWebhookDispatcher(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for s.dispatchBannerEvents(ctx) {
+		}
+		for s.deliverNextWebhook(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Turns a batch of outbox events into deliveries. Returns true if the batch was full and more events may wait
+func (s *Server) dispatchBannerEvents(ctx context.Context) bool {
+	result, err := s.db.ExecContext(ctx, dispatchBannerEventsQuery, webhookEventBatch)
+
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("dispatching banner events failed: %s", err)
+		}
+		return false
+	}
+
+	dispatched, err := result.RowsAffected()
+
+	if err != nil {
+		log.Printf("dispatching banner events failed: %s", err)
+		return false
+	}
+	return dispatched == webhookEventBatch
+}
+
+// Claims and sends one due delivery. Returns false if there was nothing to send
+func (s *Server) deliverNextWebhook(ctx context.Context) bool {
+	var id int64
+	var attempts int
+	var url, secret string
+	var event WebhookEvent
+	var changes []byte
+	err := s.db.QueryRowContext(ctx, claimWebhookDeliveryQuery, webhookLease.Seconds()).
+		Scan(&id, &attempts, &url, &secret, &event.Id, &event.Type, &event.BannerId, &changes, &event.OccurredAt)
+
+	if err != nil {
+		if err != sql.ErrNoRows && ctx.Err() == nil {
+			log.Printf("claiming webhook delivery failed: %s", err)
+		}
+		return false
+	}
+
+	status := 0
+	err = json.Unmarshal(changes, &event.Changes)
+
+	if err == nil {
+		status, err = sendWebhook(ctx, s.webhookClient, url, secret, id, event)
+	}
+
+	if err := finishWebhookDelivery(s.db, id, attempts, status, err); err != nil {
+		log.Printf("finishing webhook delivery %d failed: %s", id, err)
+	}
+	return true
+}
+
+// Posts signed event to webhook url. Returns response status, any status but 2xx is an error
+func sendWebhook(ctx context.Context, client *http.Client, url string, secret string, deliveryID int64, event WebhookEvent) (int, error) {
+	body, err := json.Marshal(event)
+
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Event", string(event.Type))
+	req.Header.Set("X-Webhook-Delivery", strconv.FormatInt(deliveryID, 10))
+	req.Header.Set("X-Webhook-Signature", webhookSignature(secret, body))
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Signs webhook body with HMAC-SHA256, receivers compare it with X-Webhook-Signature header
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Delay before attempt that follows attempts failed ones
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookBaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+
+		if delay >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return delay
+}
+
+// Marks delivery as delivered or, if deliveryErr is set, schedules retry. Delivery without attempts left becomes dead
+func finishWebhookDelivery(db *sql.DB, id int64, attempts int, status int, deliveryErr error) error {
+	var lastStatus interface{}
+	if status != 0 {
+		lastStatus = status
+	}
+
+	if deliveryErr == nil {
+		query := "UPDATE webhook_deliveries SET status = 'delivered', delivered_at = now(), last_status = $2, last_error = NULL WHERE id = $1"
+		_, err := db.Exec(query, id, lastStatus)
+		return err
+	}
+	if attempts >= webhookMaxAttempts {
+		query := "UPDATE webhook_deliveries SET status = 'dead', last_status = $2, last_error = $3 WHERE id = $1"
+		_, err := db.Exec(query, id, lastStatus, deliveryErr.Error())
+		return err
+	}
+
+	query := "UPDATE webhook_deliveries SET next_attempt_at = now() + make_interval(secs => $4), last_status = $2, last_error = $3 WHERE id = $1"
+	_, err := db.Exec(query, id, lastStatus, deliveryErr.Error(), webhookBackoff(attempts).Seconds())
+	return err
+}
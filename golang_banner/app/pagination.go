@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Columns GET /banner can be sorted by
+var bannerSortColumns = map[GetBannerParamsSort]string{
+	Id:        "id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+	FeatureId: "feature_id",
+}
+
+// Cursor of GET /banner. Holds sort of the request and sort key of the last returned banner,
+// id breaks ties between banners with equal sort key
+type bannerCursor struct {
+	Sort  GetBannerParamsSort  `json:"s"`
+	Order GetBannerParamsOrder `json:"o"`
+	Value json.RawMessage      `json:"v,omitempty"`
+	ID    int64                `json:"id"`
+}
+
+// Returns sort of GET /banner request with defaults applied
+func bannerSort(params GetBannerParams) (GetBannerParamsSort, GetBannerParamsOrder, []FieldError) {
+	sort, order := Id, Asc
+	var errs []FieldError
+
+	if params.Sort != nil {
+		sort = *params.Sort
+		if _, ok := bannerSortColumns[sort]; !ok {
+			errs = append(errs, FieldError{Field: "sort", Message: "must be one of id, created_at, updated_at, feature_id"})
+		}
+	}
+	if params.Order != nil {
+		order = *params.Order
+		if order != Asc && order != Desc {
+			errs = append(errs, FieldError{Field: "order", Message: "must be asc or desc"})
+		}
+	}
+	return sort, order, errs
+}
+
+// Builds opaque cursor pointing right after given banner
+func encodeBannerCursor(sort GetBannerParamsSort, order GetBannerParamsOrder, banner Banner) (string, error) {
+	cursor := bannerCursor{Sort: sort, Order: order, ID: banner.ID}
+
+	var value interface{}
+	switch sort {
+	case CreatedAt:
+		value = banner.CreatedAt.Format(time.RFC3339Nano)
+	case UpdatedAt:
+		value = banner.UpdatedAt.Format(time.RFC3339Nano)
+	case FeatureId:
+		value = banner.FeatureID
+	}
+
+	if value != nil {
+		raw, err := json.Marshal(value)
+
+		if err != nil {
+			return "", err
+		}
+		cursor.Value = raw
+	}
+
+	data, err := json.Marshal(cursor)
+
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decodes cursor and checks that it was issued for the same sort
+func decodeBannerCursor(encoded string, sort GetBannerParamsSort, order GetBannerParamsOrder) (bannerCursor, error) {
+	var cursor bannerCursor
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return cursor, errors.New("malformed cursor")
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, errors.New("malformed cursor")
+	}
+	if cursor.Sort != sort || cursor.Order != order {
+		return cursor, fmt.Errorf("cursor was issued for sort=%s&order=%s", cursor.Sort, cursor.Order)
+	}
+	if _, err := cursor.value(); err != nil {
+		return cursor, errors.New("malformed cursor")
+	}
+	return cursor, nil
+}
+
+// Returns sort key stored in cursor as query argument. Cursors sorted by id have no separate sort key
+func (c bannerCursor) value() (interface{}, error) {
+	switch c.Sort {
+	case CreatedAt, UpdatedAt:
+		var value time.Time
+		err := json.Unmarshal(c.Value, &value)
+		return value, err
+	case FeatureId:
+		var value int
+		err := json.Unmarshal(c.Value, &value)
+		return value, err
+	default:
+		return nil, nil
+	}
+}
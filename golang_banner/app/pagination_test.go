@@ -0,0 +1,101 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBannerCursorRoundTrip(t *testing.T) {
+	updatedAt := time.Date(2024, 4, 10, 12, 30, 0, 123456000, time.UTC)
+	banner := Banner{ID: 42, FeatureID: 7, UpdatedAt: updatedAt}
+
+	encoded, err := encodeBannerCursor(UpdatedAt, Desc, banner)
+	assert.NoError(t, err)
+
+	cursor, err := decodeBannerCursor(encoded, UpdatedAt, Desc)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), cursor.ID)
+
+	value, err := cursor.value()
+	assert.NoError(t, err)
+	assert.True(t, updatedAt.Equal(value.(time.Time)))
+
+	_, err = decodeBannerCursor(encoded, UpdatedAt, Asc)
+	assert.Error(t, err)
+
+	_, err = decodeBannerCursor("not a cursor", Id, Asc)
+	assert.Error(t, err)
+}
+
+func TestGetBannerQueryBuilder(t *testing.T) {
+	isActive, limit := true, 10
+	params := GetBannerParams{IsActive: &isActive, Limit: &limit}
+
+	query, args := getBannerQueryBuilder(params, Id, Asc, nil)
+	assert.Equal(t, "SELECT "+bannerColumns+" FROM banners WHERE deleted_at IS NULL AND is_active = $1 ORDER BY id ASC LIMIT $2", query)
+	assert.Equal(t, []interface{}{true, 11}, args)
+
+	cursor := &bannerCursor{Sort: FeatureId, Order: Desc, Value: []byte("5"), ID: 9}
+	query, args = getBannerQueryBuilder(params, FeatureId, Desc, cursor)
+	assert.Equal(t, "SELECT "+bannerColumns+" FROM banners WHERE deleted_at IS NULL AND is_active = $1 AND (feature_id, id) < ($2, $3) ORDER BY feature_id DESC, id DESC LIMIT $4", query)
+	assert.Equal(t, []interface{}{true, 5, int64(9), 11}, args)
+}
+
+func TestGetBannerNextCursor(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	db_mock.ExpectQuery("SELECT count(*) FROM banners WHERE deleted_at IS NULL").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	rows := sqlmock.NewRows([]string{"id", "tag_ids", "feature_id", "content", "is_active", "created_at", "updated_at", "revision", "priority", "deleted_at", "state", "published_revision", "published_at", "review_comment"}).
+		AddRow(1, "{1}", 2, []byte(`{}`), true, now, now, 1, 0, nil, "published", 1, now, nil).
+		AddRow(2, "{1}", 2, []byte(`{}`), true, now, now, 1, 0, nil, "published", 1, now, nil)
+	db_mock.ExpectQuery("SELECT " + bannerColumns + " FROM banners WHERE deleted_at IS NULL ORDER BY id ASC LIMIT $1").
+		WithArgs(2).
+		WillReturnRows(rows)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner?limit=1", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBanner(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "3", rec.Header().Get("X-Total-Count"))
+
+		cursor, err := decodeBannerCursor(rec.Header().Get("X-Next-Cursor"), Id, Asc)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), cursor.ID)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetBannerCursorWithOffset(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner?offset=10&cursor=abc", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBanner(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// Media types accepted by PATCH /banner/{id}
+const (
+	mimeMergePatch = "application/merge-patch+json"
+	mimeJSONPatch  = "application/json-patch+json"
+)
+
+// Builds JSON document of banner fields that can be patched
+func bannerDocument(banner Banner) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"tag_ids":    banner.TagIDs,
+		"feature_id": banner.FeatureID,
+		"content":    banner.Content,
+		"is_active":  banner.IsActive,
+		"priority":   banner.Priority,
+	})
+}
+
+// Builds banner document of validated input, in the same form as bannerDocument
+func bannerInputDocument(input BannerInput, contentJSON []byte) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"tag_ids":    input.TagIds,
+		"feature_id": input.FeatureId,
+		"content":    json.RawMessage(contentJSON),
+		"is_active":  input.IsActive,
+		"priority":   bannerPriority(input),
+	})
+}
+
+// Applies PATCH body to the current banner document. Plain JSON and merge patch bodies follow RFC 7396,
+// JSON Patch operations (RFC 6902) are applied to the content document only.
+// Requests without Content-Type are treated as plain JSON. Returns the patched document and the fields changed by the patch.
+// Error is returned only for unsupported media types
+func applyBannerPatch(current []byte, contentType string, patch []byte) ([]byte, []string, []FieldError, error) {
+	mediaType := echo.MIMEApplicationJSON
+
+	if contentType != "" {
+		var err error
+		mediaType, _, err = mime.ParseMediaType(contentType)
+
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unsupported media type %q", contentType)
+		}
+	}
+
+	switch mediaType {
+	case echo.MIMEApplicationJSON, mimeMergePatch:
+		doc, fields, fieldErrs := applyMergePatch(current, patch)
+		return doc, fields, fieldErrs, nil
+	case mimeJSONPatch:
+		doc, fieldErrs := applyContentJSONPatch(current, patch)
+
+		if len(fieldErrs) > 0 {
+			return nil, nil, fieldErrs, nil
+		}
+		return doc, []string{"content"}, nil, nil
+	default:
+		return nil, nil, nil, fmt.Errorf("unsupported media type %q", mediaType)
+	}
+}
+
+// Merges patch into the banner document. Top level fields can't be removed, so null is rejected for them
+func applyMergePatch(current []byte, patch []byte) ([]byte, []string, []FieldError) {
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(patch, &raw); err != nil || raw == nil {
+		return nil, nil, []FieldError{{Field: "body", Message: "must be a JSON object"}}
+	}
+
+	var fields []string
+	var errs []FieldError
+	for _, field := range bannerInputFields {
+		value, ok := raw[field]
+
+		if !ok {
+			continue
+		}
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			errs = append(errs, FieldError{Field: field, Message: "must not be null"})
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+
+	doc, err := jsonpatch.MergePatch(current, patch)
+
+	if err != nil {
+		return nil, nil, []FieldError{{Field: "body", Message: err.Error()}}
+	}
+	return doc, fields, nil
+}
+
+// Applies JSON Patch operations to the content field of the banner document
+func applyContentJSONPatch(current []byte, patch []byte) ([]byte, []FieldError) {
+	operations, err := jsonpatch.DecodePatch(patch)
+
+	if err != nil {
+		return nil, []FieldError{{Field: "body", Message: "must be an array of JSON Patch operations"}}
+	}
+
+	var doc map[string]json.RawMessage
+
+	if err := json.Unmarshal(current, &doc); err != nil {
+		return nil, []FieldError{{Field: "body", Message: err.Error()}}
+	}
+
+	content, err := operations.Apply(doc["content"])
+
+	if err != nil {
+		return nil, []FieldError{{Field: "content", Message: err.Error()}}
+	}
+
+	doc["content"] = content
+	patched, err := json.Marshal(doc)
+
+	if err != nil {
+		return nil, []FieldError{{Field: "content", Message: err.Error()}}
+	}
+	return patched, nil
+}
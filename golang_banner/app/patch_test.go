@@ -0,0 +1,135 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyBannerPatch(t *testing.T) {
+	current := []byte(`{"tag_ids": [1, 2], "feature_id": 3, "content": {"title": "t", "url": "u"}, "is_active": false, "priority": 0}`)
+
+	tests := []struct {
+		name        string
+		contentType string
+		patch       string
+		document    string
+		fields      []string
+		errFields   []string
+		err         bool
+	}{
+		{
+			name:        "merge single field",
+			contentType: mimeMergePatch,
+			patch:       `{"is_active": true}`,
+			document:    `{"tag_ids": [1, 2], "feature_id": 3, "content": {"title": "t", "url": "u"}, "is_active": true, "priority": 0}`,
+			fields:      []string{"is_active"},
+		},
+		{
+			name:        "merge content without content type",
+			contentType: "",
+			patch:       `{"content": {"url": null, "text": "x"}}`,
+			document:    `{"tag_ids": [1, 2], "feature_id": 3, "content": {"title": "t", "text": "x"}, "is_active": false, "priority": 0}`,
+			fields:      []string{"content"},
+		},
+		{
+			name:        "null top level field",
+			contentType: "application/json; charset=utf-8",
+			patch:       `{"feature_id": null}`,
+			errFields:   []string{"feature_id"},
+		},
+		{
+			name:        "json patch on content",
+			contentType: mimeJSONPatch,
+			patch:       `[{"op": "replace", "path": "/title", "value": "new"}, {"op": "remove", "path": "/url"}]`,
+			document:    `{"tag_ids": [1, 2], "feature_id": 3, "content": {"title": "new"}, "is_active": false, "priority": 0}`,
+			fields:      []string{"content"},
+		},
+		{
+			name:        "json patch on missing path",
+			contentType: mimeJSONPatch,
+			patch:       `[{"op": "remove", "path": "/missing"}]`,
+			errFields:   []string{"content"},
+		},
+		{
+			name:        "unsupported media type",
+			contentType: "text/plain",
+			patch:       `{}`,
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			document, fields, fieldErrs, err := applyBannerPatch(current, test.contentType, []byte(test.patch))
+
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			var errFields []string
+			for _, fieldErr := range fieldErrs {
+				errFields = append(errFields, fieldErr.Field)
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.errFields, errFields)
+			assert.Equal(t, test.fields, fields)
+
+			if test.document != "" {
+				assert.JSONEq(t, test.document, string(document))
+			}
+		})
+	}
+}
+
+func TestPatchBannerIsActiveOnly(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	rows := sqlmock.NewRows([]string{"tag_ids", "feature_id", "content", "is_active", "priority", "revision"}).
+		AddRow("{2,3}", 2, []byte(`{"key": "value"}`), false, 0, 4)
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT tag_ids, feature_id, content, is_active, priority, revision FROM banners WHERE id = $1 AND deleted_at IS NULL FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(rows)
+	db_mock.ExpectQuery("UPDATE banners SET is_active = $1, state = 'draft' WHERE id = $2 AND revision = $3 RETURNING revision").
+		WithArgs(true, 7, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(5))
+	// Only the changed field gets into audit
+	db_mock.ExpectExec("INSERT INTO audit_log (actor, action, banner_id, diff, request_id, source_ip) VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''))").
+		WithArgs(sqlmock.AnyArg(), auditUpdate, 7, []byte(`{"is_active":{"after":true,"before":false}}`), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Draft is turned on, users get it only after publish, so webhooks get plain update
+	db_mock.ExpectExec("INSERT INTO banner_events (type, banner_id, changes) SELECT unnest($1::text[]), $2, $3").
+		WithArgs(pq.Array([]string{eventUpdated}), 7, []byte(`{"is_active":{"after":true,"before":false}}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	db_mock.ExpectCommit()
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/banner/7", strings.NewReader(`{"is_active": true}`))
+	req.Header.Set(echo.HeaderContentType, mimeMergePatch)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	if assert.NoError(t, wrapper.PatchBannerId(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"5"`, rec.Header().Get("ETag"))
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
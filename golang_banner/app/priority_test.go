@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBannerConflicts(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	query := `SELECT published_feature_id, tag_id, array_agg(id ORDER BY published_priority DESC, cardinality(published_tag_ids), published_at DESC, id)
+	FROM banners, unnest(published_tag_ids) AS tag_id
+	WHERE published_revision IS NOT NULL AND deleted_at IS NULL
+	GROUP BY published_feature_id, tag_id
+	HAVING count(*) > 1
+	ORDER BY published_feature_id, tag_id`
+	rows := sqlmock.NewRows([]string{"feature_id", "tag_id", "banner_ids"}).
+		AddRow(1, 2, "{5,3}").
+		AddRow(4, 1, "{7,8,9}")
+	db_mock.ExpectQuery(query).WillReturnRows(rows)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner/conflicts", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBannerConflicts(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var conflicts []BannerConflict
+		err := json.Unmarshal(rec.Body.Bytes(), &conflicts)
+
+		if err != nil {
+			t.Fatalf("Error occcured: %s", err.Error())
+		}
+
+		assert.Equal(t, []BannerConflict{
+			{FeatureId: 1, TagId: 2, BannerIds: []int{5, 3}},
+			{FeatureId: 4, TagId: 1, BannerIds: []int{7, 8, 9}},
+		}, conflicts)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetBannerConflictsForbidden(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner/conflicts", nil)
+	req.Header.Set("token", "IMACREEP")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBannerConflicts(c)) {
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	}
+}
+
+func TestPostBannerPriorityConflict(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	expectBannerRefs(db_mock, 2, []int{1, 4})
+	expectContentSchema(db_mock, 2, nil)
+	expectFeatureLock(db_mock, 2)
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(2, 3, pq.Array([]int{1, 4}), 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "t"}).AddRow(10, 4))
+	db_mock.ExpectRollback()
+
+	e := echo.New()
+	body := `{"tag_ids": [1, 4], "feature_id": 2, "content": {}, "is_active": true, "priority": 3}`
+	req := httptest.NewRequest(http.MethodPost, "/banner", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBanner(c)) {
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		var response ValidationError
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+		if err != nil {
+			t.Fatalf("Error occcured: %s", err.Error())
+		}
+
+		assert.Equal(t, []FieldError{{Field: "tag_ids", Message: "banner 10 already has feature 2, tag 4 and priority 3"}}, response.Fields)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
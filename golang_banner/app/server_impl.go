@@ -14,11 +14,24 @@ import (
 	"github.com/lib/pq"
 )
 
+// How long user banners are kept in cache
+const userBannerCacheTTL = 5 * time.Minute
+
 type Server struct {
 	tokens map[string]string
 	db     *sql.DB
 	cache  *redis.Client
 	ctx    context.Context
+	// Rejects PATCH and DELETE requests without If-Match header
+	requireIfMatch bool
+	// Wakes up job worker when a job is queued
+	jobWakeup chan struct{}
+	// Version of the latest embedded migration, readiness check compares database schema with it
+	schemaVersion int
+	// Sends webhook deliveries
+	webhookClient *http.Client
+	// Open user banner streams of this instance
+	streams *bannerStreamHub
 }
 
 type Banner struct {
@@ -29,6 +42,17 @@ type Banner struct {
 	IsActive  bool            `json:"is_active"`
 	CreatedAt time.Time       `json:"created_at"`
 	UpdatedAt time.Time       `json:"updated_at"`
+	Revision  int64           `json:"revision"`
+	Priority  int             `json:"priority"`
+	DeletedAt *time.Time      `json:"deleted_at,omitempty"`
+	State     string          `json:"state"`
+	// Empty until banner is published for the first time and after it is archived
+	PublishedRevision *int64     `json:"published_revision,omitempty"`
+	PublishedAt       *time.Time `json:"published_at,omitempty"`
+	ReviewComment     *string    `json:"review_comment,omitempty"`
+	// Filled only when names are embedded
+	Feature *EntityRef  `json:"feature,omitempty"`
+	Tags    []EntityRef `json:"tags,omitempty"`
 }
 
 func (s *Server) GetBanner(ctx echo.Context, params GetBannerParams) error {
@@ -38,26 +62,109 @@ func (s *Server) GetBanner(ctx echo.Context, params GetBannerParams) error {
 	if !validateAdminToken(*params.Token, s.tokens) {
 		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
 	}
-	query, args := getBannerQueryBuilder(params)
-	rows, err := s.db.Query(query, args...)
+	page, writeErr, err := s.listBanners(params)
 
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, err.Error())
 	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+
+	ctx.Response().Header().Set("X-Total-Count", strconv.Itoa(page.total))
+	if page.nextCursor != "" {
+		ctx.Response().Header().Set("X-Next-Cursor", page.nextCursor)
+	}
+	return ctx.JSON(http.StatusOK, page.banners)
+}
+
+// Page of banner list with cursor of the next page, empty on the last one, and count of all matching banners
+type bannerPage struct {
+	banners    []Banner
+	nextCursor string
+	total      int
+}
+
+// Checks filters and reads page of banners. Used by REST and gRPC APIs
+func (s *Server) listBanners(params GetBannerParams) (bannerPage, *bannerWriteError, error) {
+	var page bannerPage
+	sort, order, fieldErrs := bannerSort(params)
+	fieldErrs = append(fieldErrs, validateBannerFilters(params)...)
+
+	if params.Limit != nil && *params.Limit < 0 {
+		fieldErrs = append(fieldErrs, FieldError{Field: "limit", Message: "must not be negative"})
+	}
+	if params.Offset != nil && *params.Offset < 0 {
+		fieldErrs = append(fieldErrs, FieldError{Field: "offset", Message: "must not be negative"})
+	}
+	if params.Cursor != nil && params.Offset != nil {
+		fieldErrs = append(fieldErrs, FieldError{Field: "cursor", Message: "can't be used together with offset"})
+	}
+	if params.Embed != nil && *params.Embed != Names {
+		fieldErrs = append(fieldErrs, FieldError{Field: "embed", Message: "must be names"})
+	}
+	if len(fieldErrs) > 0 {
+		return page, invalidBannerError(fieldErrs), nil
+	}
+
+	var cursor *bannerCursor
+	if params.Cursor != nil {
+		decoded, err := decodeBannerCursor(*params.Cursor, sort, order)
+
+		if err != nil {
+			return page, invalidBannerError([]FieldError{{Field: "cursor", Message: err.Error()}}), nil
+		}
+		cursor = &decoded
+	}
+
+	countQuery, countArgs := countBannersQueryBuilder(params)
+	err := s.db.QueryRow(countQuery, countArgs...).Scan(&page.total)
+
+	if err != nil && isContentPathError(err) {
+		return page, invalidBannerError([]FieldError{{Field: "content_path", Message: err.Error()}}), nil
+	} else if err != nil {
+		return page, nil, err
+	}
+
+	query, args := getBannerQueryBuilder(params, sort, order, cursor)
+	rows, err := s.db.Query(query, args...)
+
+	if err != nil {
+		return page, nil, err
+	}
 	defer rows.Close()
-	var banners []Banner
 	for rows.Next() {
-		var banner Banner
-		err := rows.Scan(&banner.ID, pq.Array(&banner.TagIDs), &banner.FeatureID, &banner.Content, &banner.IsActive, &banner.CreatedAt, &banner.UpdatedAt)
+		banner, err := scanBanner(rows)
 		if err != nil {
-			return ctx.JSON(http.StatusInternalServerError, err.Error())
+			return page, nil, err
+		}
+		page.banners = append(page.banners, banner)
+	}
+	if err := rows.Err(); err != nil {
+		return page, nil, err
+	}
+
+	if params.Limit != nil && len(page.banners) > *params.Limit {
+		page.banners = page.banners[:*params.Limit]
+
+		if len(page.banners) > 0 {
+			next, err := encodeBannerCursor(sort, order, page.banners[len(page.banners)-1])
+
+			if err != nil {
+				return page, nil, err
+			}
+			page.nextCursor = next
+		}
+	}
+	if params.Embed != nil {
+		if err := embedBannerNames(s.db, page.banners); err != nil {
+			return page, nil, err
 		}
-		banners = append(banners, banner)
 	}
-	return ctx.JSON(http.StatusOK, banners)
+	return page, nil, nil
 }
 
-func (s *Server) PostBanner(ctx echo.Context, params PostBannerParams) error {
+func (s *Server) GetBannerConflicts(ctx echo.Context, params GetBannerConflictsParams) error {
 	if !validateToken(*params.Token, s.tokens) {
 		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
 	}
@@ -65,35 +172,79 @@ func (s *Server) PostBanner(ctx echo.Context, params PostBannerParams) error {
 		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
 	}
 
-	var data map[string]interface{}
+	conflicts, err := s.listBannerConflicts()
 
-	if err := ctx.Bind(&data); err != nil {
-		return ctx.JSON(http.StatusBadRequest, err.Error())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
 	}
+	return ctx.JSON(http.StatusOK, conflicts)
+}
 
-	var content map[string]interface{}
-	var feature_id int
-	var tag_ids []int
-	var is_active bool
-	err := jsonToParams(data, &content, &feature_id, &tag_ids, &is_active)
+// Reads feature and tag pairs several published banners match. Used by REST and gRPC APIs
+func (s *Server) listBannerConflicts() ([]BannerConflict, error) {
+	// Only published revisions are served to users. Banners of each pair are listed in the order userBannerQuery picks them
+	query := `SELECT published_feature_id, tag_id, array_agg(id ORDER BY published_priority DESC, cardinality(published_tag_ids), published_at DESC, id)
+	FROM banners, unnest(published_tag_ids) AS tag_id
+	WHERE published_revision IS NOT NULL AND deleted_at IS NULL
+	GROUP BY published_feature_id, tag_id
+	HAVING count(*) > 1
+	ORDER BY published_feature_id, tag_id`
+	rows, err := s.db.Query(query)
 
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+	conflicts := []BannerConflict{}
+	for rows.Next() {
+		var conflict BannerConflict
+		var bannerIDs []int64
+		err := rows.Scan(&conflict.FeatureId, &conflict.TagId, pq.Array(&bannerIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range bannerIDs {
+			conflict.BannerIds = append(conflict.BannerIds, int(id))
+		}
+		conflicts = append(conflicts, conflict)
 	}
+	return conflicts, rows.Err()
+}
 
-	contentJSON, err := json.Marshal(content)
+func (s *Server) PostBanner(ctx echo.Context, params PostBannerParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	data, err := readRequestBody(ctx.Request().Body)
 
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, err.Error())
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	input, fieldErrs := decodeBannerInput(data)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
 	}
 
 	var id int
-	query := `INSERT INTO banners (content, feature_id, tag_ids, is_active) VALUES ($1, $2, $3, $4) RETURNING id`
-	err = s.db.QueryRow(query, contentJSON, feature_id, pq.Array(tag_ids), is_active).Scan(&id)
+	audit := s.auditSource(ctx, *params.Token)
+	writeErr, err := s.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+		created, writeErr, err := createBanner(tx, input, audit)
+		id = created
+		return writeErr, err
+	})
 
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, err.Error())
 	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
 	return ctx.JSON(http.StatusCreated, id)
 }
 
@@ -105,21 +256,38 @@ func (s *Server) DeleteBannerId(ctx echo.Context, id int, params DeleteBannerIdP
 		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
 	}
 
-	var scanID int
-	query := "DELETE FROM banners WHERE id = $1 RETURNING  id"
-	err := s.db.QueryRow(query, id).Scan(&scanID)
+	if s.requireIfMatch && params.IfMatch == nil {
+		return ctx.HTML(http.StatusPreconditionRequired, "Требуется заголовок If-Match")
+	}
+
+	writeErr, err := s.removeBanner(id, params.IfMatch, s.auditSource(ctx, *params.Token))
 
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return ctx.HTML(http.StatusNotFound, "Баннер не найден")
-		} else {
-			return ctx.JSON(http.StatusInternalServerError, err.Error())
-		}
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
 	}
-	return ctx.HTML(http.StatusNoContent, "Баннер успешно удалён")
+	return ctx.NoContent(http.StatusNoContent)
 }
 
-func (s *Server) PatchBannerId(ctx echo.Context, id int, params PatchBannerIdParams) error {
+// Moves banner to trash in a transaction and drops cached user banners of its published feature. Used by REST and gRPC APIs
+func (s *Server) removeBanner(id int, ifMatch *string, audit auditSource) (*bannerWriteError, error) {
+	var featureIDs []int
+	writeErr, err := s.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+		var writeErr *bannerWriteError
+		var err error
+		featureIDs, writeErr, err = deleteBanner(tx, id, ifMatch, audit)
+		return writeErr, err
+	})
+
+	if err != nil || writeErr != nil {
+		return writeErr, err
+	}
+	return nil, invalidateFeatureCache(s.ctx, s.cache, featureIDs)
+}
+
+func (s *Server) GetBannerId(ctx echo.Context, id int, params GetBannerIdParams) error {
 	if !validateToken(*params.Token, s.tokens) {
 		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
 	}
@@ -127,45 +295,70 @@ func (s *Server) PatchBannerId(ctx echo.Context, id int, params PatchBannerIdPar
 		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
 	}
 
-	var data map[string]interface{} = make(map[string]interface{})
+	banner, writeErr, err := s.findBanner(id)
 
-	if err := ctx.Bind(&data); err != nil {
-		return ctx.JSON(http.StatusBadRequest, err.Error())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
 	}
 
-	var content map[string]interface{}
-	var feature_id int
-	var tag_ids []int
-	var is_active bool
-	err := jsonToParams(data, &content, &feature_id, &tag_ids, &is_active)
+	etag := bannerETag(banner.Revision)
+	ctx.Response().Header().Set("ETag", etag)
 
-	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, err.Error())
+	if params.IfNoneMatch != nil && etagMatches(*params.IfNoneMatch, etag) {
+		return ctx.NoContent(http.StatusNotModified)
 	}
+	return ctx.JSON(http.StatusOK, banner)
+}
 
-	contentJSON, err := json.Marshal(content)
+// Reads banner that is not in trash. Used by REST and gRPC APIs
+func (s *Server) findBanner(id int) (Banner, *bannerWriteError, error) {
+	query := "SELECT " + bannerColumns + " FROM banners WHERE id = $1 AND deleted_at IS NULL"
+	banner, err := scanBanner(s.db.QueryRow(query, id))
 
-	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, err.Error())
+	if err == sql.ErrNoRows {
+		return banner, &bannerWriteError{status: http.StatusNotFound, message: "Баннер не найден"}, nil
+	}
+	return banner, nil, err
+}
+
+func (s *Server) PatchBannerId(ctx echo.Context, id int, params PatchBannerIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	if s.requireIfMatch && params.IfMatch == nil {
+		return ctx.HTML(http.StatusPreconditionRequired, "Требуется заголовок If-Match")
 	}
 
-	query := ` UPDATE banners
-	SET content = $1, feature_id = $2, tag_ids = $3, is_active = $4
-	WHERE id = $5;`
-	res, err := s.db.Exec(query, contentJSON, feature_id, pq.Array(tag_ids), is_active, id)
+	data, err := readRequestBody(ctx.Request().Body)
 
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, err.Error())
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
 	}
 
-	count, err := res.RowsAffected()
+	var revision int64
+	contentType := ctx.Request().Header.Get(echo.HeaderContentType)
+	audit := s.auditSource(ctx, *params.Token)
+	writeErr, err := s.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+		patched, writeErr, err := patchBanner(tx, id, params.IfMatch, contentType, data, audit)
+		revision = patched
+		return writeErr, err
+	})
 
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, err.Error())
 	}
-	if count == 0 {
-		return ctx.HTML(http.StatusNotFound, "Баннер не найден")
+	if writeErr != nil {
+		return writeErr.respond(ctx)
 	}
+
+	ctx.Response().Header().Set("ETag", bannerETag(revision))
 	return ctx.HTML(http.StatusOK, "OK")
 }
 
@@ -174,65 +367,179 @@ func (s *Server) GetUserBanner(ctx echo.Context, params GetUserBannerParams) err
 		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
 	}
 
-	var result map[string]interface{}
-	var is_active bool
-	if params.UseLastRevision == nil || !*params.UseLastRevision {
-		value, err := s.cache.Get(s.ctx, fmt.Sprintf("%d:%d", params.FeatureId, params.TagId)).Result()
+	lastRevision := params.UseLastRevision != nil && *params.UseLastRevision
+	banner, writeErr, err := s.lookupUserBanner(*params.Token, params.FeatureId, params.TagId, lastRevision, params.IfNoneMatch)
 
-		if err == nil {
-			err := json.Unmarshal([]byte(value), &result)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
 
-			if err != nil {
-				return ctx.JSON(http.StatusInternalServerError, err.Error())
-			}
+	ctx.Response().Header().Set("X-Matched-Tag-Id", strconv.Itoa(banner.matchedTag))
+	setUserBannerCacheHeaders(ctx, banner.etag, lastRevision)
 
-			activeVal, err := s.cache.Get(s.ctx, fmt.Sprintf("%d:%d:isactive", params.FeatureId, params.TagId)).Result()
+	if banner.notModified || params.IfNoneMatch != nil && etagMatches(*params.IfNoneMatch, banner.etag) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+	return ctx.JSONBlob(http.StatusOK, banner.content)
+}
 
-			if err != nil {
-				return ctx.JSON(http.StatusInternalServerError, err.Error())
-			}
+// Banner user gets for feature and tags, with the tag it was matched by
+type userBanner struct {
+	content    []byte
+	matchedTag int
+	etag       string
+	// Client already has the banner, content was not read
+	notModified bool
+}
 
-			is_active, err = strconv.ParseBool(activeVal)
+// Finds banner user gets for feature and tags, first in cache, then in database, caching it.
+// Admins asking for the last revision bypass cache and preview the last revision, which is not cached.
+// When cached banner matches ifNoneMatch, its content is not read. Used by REST and gRPC APIs
+func (s *Server) lookupUserBanner(token string, featureID int, tagIDs []int, lastRevision bool, ifNoneMatch *string) (userBanner, *bannerWriteError, error) {
+	tagIDs = uniqueIDs(tagIDs)
 
-			if err != nil {
-				return ctx.JSON(http.StatusInternalServerError, err.Error())
-			}
+	if len(tagIDs) > maxTagsPerBanner {
+		return userBanner{}, invalidBannerError([]FieldError{{Field: "tag_id", Message: fmt.Sprintf("must contain at most %d tags", maxTagsPerBanner)}}), nil
+	}
 
-			if !is_active && !validateAdminToken(*params.Token, s.tokens) {
-				return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	key := userBannerCacheKey(featureID, tagIDs...)
+	if !lastRevision {
+		entry, ok := s.getCachedUserBanner(key, ifNoneMatch == nil)
+
+		if ok && ifNoneMatch != nil {
+			if !canViewBanner(token, entry.isActive, s.tokens) {
+				return userBanner{}, &bannerWriteError{status: http.StatusForbidden, message: "Пользователь не имеет доступа"}, nil
+			}
+			if etagMatches(*ifNoneMatch, entry.etag) {
+				return userBanner{matchedTag: entry.tag, etag: entry.etag, notModified: true}, nil, nil
 			}
-			return ctx.JSON(http.StatusOK, result)
+			// Client has another banner, so content is needed now
+			entry, ok = s.getCachedUserBanner(key, true)
 		}
+		if ok {
+			if !canViewBanner(token, entry.isActive, s.tokens) {
+				return userBanner{}, &bannerWriteError{status: http.StatusForbidden, message: "Пользователь не имеет доступа"}, nil
+			}
+			return userBanner{content: entry.content, matchedTag: entry.tag, etag: entry.etag}, nil, nil
+		}
+	}
+
+	// Admins bypassing cache preview the last revision, which must not get into cache users read
+	preview := lastRevision && validateAdminToken(token, s.tokens)
+	query := userBannerQuery
+
+	if preview {
+		query = previewUserBannerQuery
 	}
 
-	query := "SELECT content, is_active FROM banners WHERE feature_id = ($1) AND ($2) = ANY(tag_ids)"
-	var jsonData []byte
-	err := s.db.QueryRow(query, params.FeatureId, params.TagId).Scan(&jsonData, &is_active)
+	var banner userBanner
+	var isActive bool
+	err := s.db.QueryRow(query, featureID, pq.Array(tagIDs)).Scan(&banner.content, &isActive, &banner.matchedTag)
 
 	if err != nil {
-		return ctx.HTML(http.StatusNotFound, "Баннер не найден")
+		return userBanner{}, &bannerWriteError{status: http.StatusNotFound, message: "Баннер не найден"}, nil
 	}
 
-	if !is_active && !validateAdminToken(*params.Token, s.tokens) {
-		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	if !canViewBanner(token, isActive, s.tokens) {
+		return userBanner{}, &bannerWriteError{status: http.StatusForbidden, message: "Пользователь не имеет доступа"}, nil
 	}
 
-	err = json.Unmarshal(jsonData, &result)
+	banner.etag = contentETag(banner.content)
 
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	if !preview {
+		_, err = s.cache.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+			s.cacheUserBanner(pipe, key, banner.content, isActive, banner.matchedTag, banner.etag)
+			return nil
+		})
+
+		if err != nil {
+			return userBanner{}, nil, err
+		}
 	}
+	return banner, nil, nil
+}
+
+// User banner is cached as a hash. ETag, activity and matched tag are small fields read on their own,
+// so conditional requests are answered without reading and decoding content
+var (
+	userBannerHeaderFields = []string{"etag", "is_active", "tag"}
+	userBannerCacheFields  = append(userBannerHeaderFields[:len(userBannerHeaderFields):len(userBannerHeaderFields)], "content")
+)
+
+// User banner in cache. Content is nil when only the header fields were read
+type cachedUserBanner struct {
+	etag     string
+	isActive bool
+	tag      int
+	content  []byte
+}
+
+// Queues commands storing user banner to cache pipeline. Entries of older versions are plain strings,
+// so the key is dropped first. Fields get one TTL and can't expire apart
+func (s *Server) cacheUserBanner(pipe redis.Pipeliner, key string, content []byte, isActive bool, matchedTag int, etag string) {
+	pipe.Del(s.ctx, key)
+	pipe.HSet(s.ctx, key, "etag", etag, "is_active", isActive, "tag", matchedTag, "content", content)
+	pipe.Expire(s.ctx, key, userBannerCacheTTL)
+}
 
-	err = s.cache.Set(s.ctx, fmt.Sprintf("%d:%d", params.FeatureId, params.TagId), jsonData, 5*time.Minute).Err()
+// Reads cached user banner, with content or only its header fields
+func (s *Server) getCachedUserBanner(key string, withContent bool) (cachedUserBanner, bool) {
+	fields := userBannerHeaderFields
+
+	if withContent {
+		fields = userBannerCacheFields
+	}
+
+	values, err := s.cache.HMGet(s.ctx, key, fields...).Result()
 
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, err.Error())
+		return cachedUserBanner{}, false
 	}
+	return decodeCachedUserBanner(values)
+}
 
-	err = s.cache.Set(s.ctx, fmt.Sprintf("%d:%d:isactive", params.FeatureId, params.TagId), is_active, 5*time.Minute).Err()
+// Decodes values of cached user banner fields in the order of userBannerCacheFields. Missing entries and entries
+// without ETag are treated as missing
+func decodeCachedUserBanner(values []interface{}) (cachedUserBanner, bool) {
+	var entry cachedUserBanner
+	strs := make([]string, len(values))
+	for i, value := range values {
+		str, ok := value.(string)
+
+		if !ok {
+			return entry, false
+		}
+		strs[i] = str
+	}
+	if len(strs) < len(userBannerHeaderFields) || strs[0] == "" {
+		return entry, false
+	}
+
+	tag, err := strconv.Atoi(strs[2])
 
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, err.Error())
+		return entry, false
+	}
+
+	entry.etag = strs[0]
+	entry.isActive = strs[1] == "1"
+	entry.tag = tag
+	if len(strs) > len(userBannerHeaderFields) {
+		entry.content = []byte(strs[3])
+	}
+	return entry, true
+}
+
+// Sets ETag and Cache-Control headers of user banner response. Clients asking for the last revision have to revalidate every time
+func setUserBannerCacheHeaders(ctx echo.Context, etag string, lastRevision bool) {
+	ctx.Response().Header().Set("ETag", etag)
+
+	if lastRevision {
+		ctx.Response().Header().Set("Cache-Control", "private, no-cache")
+	} else {
+		ctx.Response().Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", int(userBannerCacheTTL.Seconds())))
 	}
-	return ctx.JSON(http.StatusOK, result)
 }
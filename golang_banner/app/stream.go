@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+// Redis channel announcing features whose served banners changed. Message is the feature id,
+// every instance relays it to its own streams
+const bannerUpdatesChannel = "banner_updates"
+
+// How often stream sends a comment, so proxies do not close idle connection
+const streamKeepAlive = 15 * time.Second
+
+// Open user banner streams of this instance, grouped by feature
+type bannerStreamHub struct {
+	mu      sync.Mutex
+	streams map[int]map[chan struct{}]struct{}
+}
+
+func newBannerStreamHub() *bannerStreamHub {
+	return &bannerStreamHub{streams: make(map[int]map[chan struct{}]struct{})}
+}
+
+// Registers stream of feature. Returned channel is signalled when banners of the feature change,
+// signals that come while stream is busy are merged into one
+func (h *bannerStreamHub) subscribe(featureID int) chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	updates := make(chan struct{}, 1)
+	if h.streams[featureID] == nil {
+		h.streams[featureID] = make(map[chan struct{}]struct{})
+	}
+	h.streams[featureID][updates] = struct{}{}
+	return updates
+}
+
+func (h *bannerStreamHub) unsubscribe(featureID int, updates chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.streams[featureID], updates)
+	if len(h.streams[featureID]) == 0 {
+		delete(h.streams, featureID)
+	}
+}
+
+// Signals streams of feature
+func (h *bannerStreamHub) notify(featureID int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for updates := range h.streams[featureID] {
+		signal(updates)
+	}
+}
+
+// Signals every stream. Used after Redis subscription is restored, updates published meanwhile are lost
+func (h *bannerStreamHub) notifyAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, streams := range h.streams {
+		for updates := range streams {
+			signal(updates)
+		}
+	}
+}
+
+func signal(updates chan struct{}) {
+	select {
+	case updates <- struct{}{}:
+	default:
+	}
+}
+
+// Relays feature updates published by any instance to streams of this one until ctx is done
+func (s *Server) runBannerStreamHub(ctx context.Context) {
+	pubsub := s.cache.Subscribe(ctx, bannerUpdatesChannel)
+	defer pubsub.Close()
+
+	messages := pubsub.ChannelWithSubscriptions(ctx, 100)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message := <-messages:
+			switch message := message.(type) {
+			case *redis.Subscription:
+				// Subscription is confirmed on start and after every reconnect
+				s.streams.notifyAll()
+			case *redis.Message:
+				featureID, err := strconv.Atoi(message.Payload)
+
+				if err != nil {
+					log.Printf("malformed banner update %q", message.Payload)
+					continue
+				}
+				s.streams.notify(featureID)
+			}
+		}
+	}
+}
+
+// Announces to streams of all instances that banners of features changed
+func publishFeatureUpdates(ctx context.Context, cache *redis.Client, featureIDs []int) error {
+	for _, featureID := range featureIDs {
+		if err := cache.Publish(ctx, bannerUpdatesChannel, featureID).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) GetUserBannerStream(ctx echo.Context, params GetUserBannerStreamParams) error {
+	tagIDs, writeErr := s.checkUserBannerStream(*params.Token, params.TagId)
+
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	// Tells nginx not to buffer the stream
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	lastID := ""
+	if params.LastEventID != nil {
+		lastID = *params.LastEventID
+	}
+
+	keepAlive := func() error {
+		if _, err := io.WriteString(res, ": keepalive\n\n"); err != nil {
+			return err
+		}
+		res.Flush()
+		return nil
+	}
+	send := func(event streamEvent) error {
+		return writeStreamEvent(res, event)
+	}
+
+	// Status is already sent, so stream is closed and client reconnects
+	if err := s.watchUserBanner(ctx.Request().Context(), *params.Token, params.FeatureId, tagIDs, lastID, send, keepAlive); err != nil {
+		log.Printf("user banner stream closed: %s", err)
+	}
+	return nil
+}
+
+// Checks token and tags of user banner stream. Returns tags to watch
+func (s *Server) checkUserBannerStream(token string, tagIDs []int) ([]int, *bannerWriteError) {
+	if !validateToken(token, s.tokens) {
+		return nil, &bannerWriteError{status: http.StatusUnauthorized, message: "Пользователь не авторизован"}
+	}
+
+	tagIDs = uniqueIDs(tagIDs)
+
+	if len(tagIDs) > maxTagsPerBanner {
+		return nil, invalidBannerError([]FieldError{{Field: "tag_id", Message: fmt.Sprintf("must contain at most %d tags", maxTagsPerBanner)}})
+	}
+	return tagIDs, nil
+}
+
+// Sends user banner event on start and after every change of the feature, until ctx is done or sending fails.
+// Event with lastID is not sent, client already has it. Used by both SSE and gRPC streams.
+// keepAlive is called when stream was idle for streamKeepAlive, nil disables it
+func (s *Server) watchUserBanner(ctx context.Context, token string, featureID int, tagIDs []int, lastID string,
+	send func(streamEvent) error, keepAlive func() error) error {
+	// Subscribing before the first read, so a change between them is not missed
+	updates := s.streams.subscribe(featureID)
+	defer s.streams.unsubscribe(featureID, updates)
+
+	var keepAliveTicks <-chan time.Time
+	if keepAlive != nil {
+		ticker := time.NewTicker(streamKeepAlive)
+		defer ticker.Stop()
+		keepAliveTicks = ticker.C
+	}
+
+	changed := true
+	for {
+		if changed {
+			event, err := s.userBannerEvent(token, featureID, tagIDs)
+
+			if err != nil {
+				return err
+			}
+			if event.id != lastID {
+				if err := send(event); err != nil {
+					return err
+				}
+				lastID = event.id
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-updates:
+			changed = true
+		case <-keepAliveTicks:
+			changed = false
+
+			if err := keepAlive(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Event of user banner stream. Banner events also carry the tag banner was matched by
+type streamEvent struct {
+	id         string
+	name       string
+	data       []byte
+	matchedTag int
+}
+
+// Builds event with banner user gets from GetUserBanner without cache. Id identifies content,
+// so equal ids mean client already has the banner
+func (s *Server) userBannerEvent(token string, featureID int, tagIDs []int) (streamEvent, error) {
+	var content []byte
+	var isActive bool
+	var matchedTag int
+	err := s.db.QueryRow(userBannerQuery, featureID, pq.Array(tagIDs)).Scan(&content, &isActive, &matchedTag)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return unavailableEvent(http.StatusNotFound), nil
+		} else {
+			return streamEvent{}, err
+		}
+	}
+	if !canViewBanner(token, isActive, s.tokens) {
+		return unavailableEvent(http.StatusForbidden), nil
+	}
+	return streamEvent{id: strings.Trim(contentETag(content), `"`), name: "banner", data: content, matchedTag: matchedTag}, nil
+}
+
+func unavailableEvent(status int) streamEvent {
+	return streamEvent{
+		id:   fmt.Sprintf("unavailable-%d", status),
+		name: "unavailable",
+		data: []byte(fmt.Sprintf(`{"status":%d}`, status)),
+	}
+}
+
+// Writes event in text/event-stream format and flushes it. Every line of data gets its own data field
+func writeStreamEvent(res *echo.Response, event streamEvent) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "id: %s\nevent: %s\n", event.id, event.name)
+	for _, line := range bytes.Split(event.data, []byte("\n")) {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+
+	if _, err := res.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
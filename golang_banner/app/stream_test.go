@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStreamTestServer(t *testing.T) (*httptest.Server, *bannerStreamHub, sqlmock.Sqlmock) {
+	server, db_mock, _ := newTestServer(t)
+	server.streams = newBannerStreamHub()
+	e := echo.New()
+	RegisterHandlers(e, server)
+	ts := httptest.NewServer(e)
+	t.Cleanup(func() {
+		ts.Close()
+	})
+	return ts, server.streams, db_mock
+}
+
+// Opens stream of feature 2 and tag 3
+func openStream(t *testing.T, ts *httptest.Server, token string, lastEventID string) (*bufio.Reader, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/user_banner/stream?feature_id=2&tag_id=3", nil)
+
+	if err != nil {
+		t.Fatalf("Error occcured: %s", err.Error())
+	}
+
+	req.Header.Set("token", token)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+
+	if err != nil {
+		t.Fatalf("Error occcured: %s", err.Error())
+	}
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	return bufio.NewReader(resp.Body), func() {
+		cancel()
+		resp.Body.Close()
+	}
+}
+
+// Reads next event, keep-alive comments are skipped
+func readStreamEvent(t *testing.T, reader *bufio.Reader) map[string]string {
+	event := map[string]string{}
+	for {
+		line, err := reader.ReadString('\n')
+
+		if err != nil {
+			t.Fatalf("Error occcured: %s", err.Error())
+		}
+
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" && len(event) > 0 {
+			return event
+		}
+		if field, value, ok := strings.Cut(line, ": "); ok && field != "" {
+			event[field] = value
+		}
+	}
+}
+
+func expectStreamBanner(db_mock sqlmock.Sqlmock, content string, isActive bool) {
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{3})).
+		WillReturnRows(sqlmock.NewRows([]string{"published_content", "published_is_active", "tag"}).AddRow([]byte(content), isActive, 3))
+}
+
+func TestGetUserBannerStream(t *testing.T) {
+	ts, streams, db_mock := newStreamTestServer(t)
+
+	expectStreamBanner(db_mock, `{"title": "a"}`, true)
+	expectStreamBanner(db_mock, `{"title": "b"}`, true)
+	expectStreamBanner(db_mock, `{"title": "b"}`, false)
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{3})).
+		WillReturnRows(sqlmock.NewRows([]string{"published_content", "published_is_active", "tag"}))
+
+	reader, closeStream := openStream(t, ts, "IMACREEP", "")
+	defer closeStream()
+
+	event := readStreamEvent(t, reader)
+	assert.Equal(t, "banner", event["event"])
+	assert.Equal(t, `{"title": "a"}`, event["data"])
+	assert.Equal(t, strings.Trim(contentETag([]byte(`{"title": "a"}`)), `"`), event["id"])
+
+	streams.notify(2)
+	event = readStreamEvent(t, reader)
+	assert.Equal(t, `{"title": "b"}`, event["data"])
+
+	// Users do not see inactive banners
+	streams.notify(2)
+	event = readStreamEvent(t, reader)
+	assert.Equal(t, "unavailable", event["event"])
+	assert.Equal(t, `{"status":403}`, event["data"])
+
+	streams.notify(2)
+	event = readStreamEvent(t, reader)
+	assert.Equal(t, `{"status":404}`, event["data"])
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetUserBannerStreamResumes(t *testing.T) {
+	ts, streams, db_mock := newStreamTestServer(t)
+	seen := strings.Trim(contentETag([]byte(`{"title": "a"}`)), `"`)
+
+	// Client already has the first banner, so only the change is sent. Admin sees inactive banners
+	expectStreamBanner(db_mock, `{"title": "a"}`, true)
+	expectStreamBanner(db_mock, `{"title": "b"}`, false)
+
+	reader, closeStream := openStream(t, ts, "IGOTTHEPOWER!", seen)
+	defer closeStream()
+
+	// Other features do not wake up the stream
+	streams.notify(5)
+	streams.notify(2)
+	event := readStreamEvent(t, reader)
+	assert.Equal(t, "banner", event["event"])
+	assert.Equal(t, `{"title": "b"}`, event["data"])
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetUserBannerStreamUnauthorized(t *testing.T) {
+	ts, _, _ := newStreamTestServer(t)
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/user_banner/stream?feature_id=2&tag_id=3", nil)
+	req.Header.Set("token", "nobody")
+	resp, err := http.DefaultClient.Do(req)
+
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+// Number of banners benchmarks run against
+const benchBannerCount = 100000
+
+// Opens database from BENCH_DATABASE_URL, migrates it and seeds it up to benchBannerCount published banners.
+// Seeded banners are never removed, so the database should be a dedicated one
+func openBenchDB(b *testing.B) *sql.DB {
+	databaseURL := os.Getenv("BENCH_DATABASE_URL")
+	if databaseURL == "" {
+		b.Skip("BENCH_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", databaseURL)
+
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { db.Close() })
+
+	migrations, err := loadMigrations(migrationFiles)
+
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := migrateUp(db, migrations); err != nil {
+		b.Fatal(err)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT count(*) FROM banners WHERE published_revision IS NOT NULL").Scan(&count); err != nil {
+		b.Fatal(err)
+	}
+
+	// 1000 features, every banner has 1 to 5 of 1000 tags
+	if count < benchBannerCount {
+		for _, table := range []string{"features", "tags"} {
+			query := "INSERT INTO " + table + " (id, name) SELECT i, 'bench ' || i FROM generate_series(1, 1000) AS i ON CONFLICT DO NOTHING"
+			if _, err := db.Exec(query); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		// Banners are published, so user banner lookups find them
+		query := `INSERT INTO banners (content, feature_id, tag_ids, is_active, state,
+			published_content, published_feature_id, published_tag_ids, published_is_active, published_priority, published_revision, published_at)
+		SELECT content, feature_id, tag_ids, true, 'published', content, feature_id, tag_ids, true, 0, 1, now()
+		FROM (
+			SELECT jsonb_build_object('title', 'banner ' || i) AS content, 1 + i % 1000 AS feature_id,
+				ARRAY(SELECT DISTINCT 1 + (i * 7919 + k * 104729) % 1000 FROM generate_series(1, 1 + i % 5) AS k) AS tag_ids
+			FROM generate_series($1::int + 1, $2::int) AS i
+		) seeded`
+		if _, err := db.Exec(query, count, benchBannerCount); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if _, err := db.Exec("ANALYZE banners"); err != nil {
+		b.Fatal(err)
+	}
+	return db
+}
+
+// Runs tag lookups with and without GIN indexes on tags. The index is dropped inside a transaction that is rolled back
+func BenchmarkTagLookup(b *testing.B) {
+	db := openBenchDB(b)
+
+	// User banner lookups read published tags, admin lookups read edited ones. Each case drops the index it uses
+	lookups := []struct {
+		name  string
+		query string
+		args  []interface{}
+		index string
+	}{
+		{"user_banner", userBannerQuery, []interface{}{42, pq.Array([]int{17, 314, 999})}, "banners_published_tag_ids_idx"},
+		{"admin_tag_filter", "SELECT count(*) FROM banners WHERE deleted_at IS NULL AND tag_ids && $1::int[]", []interface{}{pq.Array([]int{17})}, "banners_tag_ids_idx"},
+		{"bulk_delete_filter", "SELECT count(*) FROM banners WHERE deleted_at IS NULL AND (tag_ids @> ARRAY[$1::int] OR published_tag_ids @> ARRAY[$1::int])", []interface{}{17}, "banners_tag_ids_idx"},
+	}
+
+	for _, index := range []string{"with_gin", "without_gin"} {
+		for _, lookup := range lookups {
+			b.Run(index+"/"+lookup.name, func(b *testing.B) {
+				tx, err := db.Begin()
+
+				if err != nil {
+					b.Fatal(err)
+				}
+				defer tx.Rollback()
+
+				if index == "without_gin" {
+					if _, err := tx.Exec("DROP INDEX " + lookup.index); err != nil {
+						b.Fatal(err)
+					}
+				}
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					rows, err := tx.Query(lookup.query, lookup.args...)
+
+					if err != nil {
+						b.Fatal(err)
+					}
+					for rows.Next() {
+					}
+					if err := rows.Err(); err != nil {
+						b.Fatal(err)
+					}
+					rows.Close()
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+// Defaults for trash purger, overridden by TRASH_RETENTION and TRASH_PURGE_INTERVAL
+const (
+	defaultTrashRetention     = 30 * 24 * time.Hour
+	defaultTrashPurgeInterval = time.Hour
+)
+
+func (s *Server) GetBannerTrash(ctx echo.Context, params GetBannerTrashParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	banners, writeErr, err := s.listTrash(params.Limit, params.Offset)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusOK, banners)
+}
+
+// Reads page of banners in trash, the last deleted first. Used by REST and gRPC APIs
+func (s *Server) listTrash(limit *int, offset *int) ([]Banner, *bannerWriteError, error) {
+	if fieldErrs := validateLimitOffset(limit, offset); len(fieldErrs) > 0 {
+		return nil, invalidBannerError(fieldErrs), nil
+	}
+
+	query := "SELECT " + bannerColumns + " FROM banners WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC, id DESC"
+	query, args := appendLimitOffset(query, nil, limit, offset)
+	rows, err := s.db.Query(query, args...)
+
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	banners := []Banner{}
+	for rows.Next() {
+		banner, err := scanBanner(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		banners = append(banners, banner)
+	}
+	return banners, nil, rows.Err()
+}
+
+func (s *Server) PostBannerIdRestore(ctx echo.Context, id int, params PostBannerIdRestoreParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	revision, writeErr, err := s.restoreBanner(id, s.auditSource(ctx, *params.Token))
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	ctx.Response().Header().Set("ETag", bannerETag(revision))
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Takes banner out of trash in a transaction and drops cached user banners of its published feature. Used by REST and gRPC APIs
+func (s *Server) restoreBanner(id int, audit auditSource) (int64, *bannerWriteError, error) {
+	var revision int64
+	var publishedFeatureID sql.NullInt64
+	writeErr, err := s.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+		var deletedAt time.Time
+		var tagIDs []int64
+		var priority int
+		input := BannerInput{Priority: &priority}
+		query := "SELECT feature_id, tag_ids, priority, deleted_at FROM banners WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE"
+		err := tx.QueryRow(query, id).Scan(&input.FeatureId, pq.Array(&tagIDs), &priority, &deletedAt)
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &bannerWriteError{status: http.StatusNotFound, message: "Баннер не найден в корзине"}, nil
+			} else {
+				return nil, err
+			}
+		}
+		for _, tagID := range tagIDs {
+			input.TagIds = append(input.TagIds, int(tagID))
+		}
+
+		// Feature and tags could be archived or deleted while the banner was in trash
+		fieldErrs, err := checkBannerRefs(tx, input, nil)
+
+		if err != nil {
+			return nil, err
+		}
+		if len(fieldErrs) > 0 {
+			return &bannerWriteError{status: http.StatusConflict, message: "banner references archived or deleted feature or tags", fields: fieldErrs}, nil
+		}
+		if err := lockFeatures(tx, input.FeatureId); err != nil {
+			return nil, err
+		}
+
+		writeErr, err := checkBannerUnique(tx, id, input)
+
+		if err != nil || writeErr != nil {
+			return writeErr, err
+		}
+
+		query = "UPDATE banners SET deleted_at = NULL WHERE id = $1 RETURNING revision, published_feature_id"
+		err = tx.QueryRow(query, id).Scan(&revision, &publishedFeatureID)
+
+		if err != nil {
+			return nil, err
+		}
+		return nil, recordBannerChange(tx, audit, auditRestore, id, auditFieldDiff("deleted_at", deletedAt, nil))
+	})
+
+	if err != nil || writeErr != nil {
+		return revision, writeErr, err
+	}
+
+	// Restored published banner is served again
+	if publishedFeatureID.Valid {
+		err = invalidateFeatureCache(s.ctx, s.cache, []int{int(publishedFeatureID.Int64)})
+	}
+	return revision, nil, err
+}
+
+// Permanently removes banners that stayed in trash longer than retention. Returns number of removed banners
+func purgeTrash(db *sql.DB, retention time.Duration) (int64, error) {
+	query := "DELETE FROM banners WHERE deleted_at < now() - make_interval(secs => $1)"
+	result, err := db.Exec(query, retention.Seconds())
+
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// Runs purgeTrash every interval until ctx is done
+func runTrashPurger(ctx context.Context, db *sql.DB, retention time.Duration, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := purgeTrash(db, retention)
+
+			if err != nil {
+				log.Printf("trash purge failed: %s", err)
+			} else if purged > 0 {
+				log.Printf("purged %d banners from trash", purged)
+			}
+		}
+	}
+}
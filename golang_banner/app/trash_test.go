@@ -0,0 +1,200 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteBannerMovesToTrash(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("UPDATE banners SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL RETURNING deleted_at, published_feature_id").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"deleted_at", "published_feature_id"}).AddRow(time.Now(), nil))
+	expectBannerChange(db_mock, auditDelete, 7)
+	db_mock.ExpectCommit()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/banner/7", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	if assert.NoError(t, wrapper.DeleteBannerId(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetBannerTrash(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "tag_ids", "feature_id", "content", "is_active", "created_at", "updated_at", "revision", "priority", "deleted_at", "state", "published_revision", "published_at", "review_comment"}).
+		AddRow(7, "{1}", 2, []byte(`{}`), true, now, now, 2, 0, now, "published", 1, now, nil)
+	db_mock.ExpectQuery("SELECT " + bannerColumns + " FROM banners WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC, id DESC LIMIT $1").
+		WithArgs(10).
+		WillReturnRows(rows)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/banner/trash?limit=10", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetBannerTrash(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"deleted_at"`)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+// Expects restore of banner 7 to read it from trash and check its feature 1 and tag 2
+func expectRestoreChecks(db_mock sqlmock.Sqlmock) {
+	db_mock.ExpectQuery("SELECT feature_id, tag_ids, priority, deleted_at FROM banners WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"feature_id", "tag_ids", "priority", "deleted_at"}).AddRow(1, "{2}", 0, time.Now()))
+	db_mock.ExpectQuery("SELECT archived FROM features WHERE id = $1 FOR SHARE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"archived"}).AddRow(false))
+	db_mock.ExpectQuery("SELECT id, archived FROM tags WHERE id = ANY($1::int[]) FOR SHARE").
+		WithArgs(pq.Array([]int{2})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "archived"}).AddRow(2, false))
+	db_mock.ExpectExec("SELECT pg_advisory_xact_lock('banners'::regclass::oid::int, $1)").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func postBannerRestore(t *testing.T, wrapper ServerInterfaceWrapper) *httptest.ResponseRecorder {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/banner/7/restore", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	assert.NoError(t, wrapper.PostBannerIdRestore(c))
+	return rec
+}
+
+func TestPostBannerRestore(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	expectRestoreChecks(db_mock)
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(1, 0, pq.Array([]int{2}), 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag_id"}))
+	db_mock.ExpectQuery("UPDATE banners SET deleted_at = NULL WHERE id = $1 RETURNING revision, published_feature_id").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"revision", "published_feature_id"}).AddRow(3, nil))
+	expectBannerChange(db_mock, auditRestore, 7)
+	db_mock.ExpectCommit()
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT feature_id, tag_ids, priority, deleted_at FROM banners WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"feature_id", "tag_ids", "priority", "deleted_at"}))
+	db_mock.ExpectRollback()
+
+	for _, code := range []int{http.StatusNoContent, http.StatusNotFound} {
+		assert.Equal(t, code, postBannerRestore(t, wrapper).Code)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerRestoreConflict(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	// Another banner took the feature and tag while banner 7 was in trash
+	db_mock.ExpectBegin()
+	expectRestoreChecks(db_mock)
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(1, 0, pq.Array([]int{2}), 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag_id"}).AddRow(9, 2))
+	db_mock.ExpectRollback()
+
+	rec := postBannerRestore(t, wrapper)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "banner 9 already has feature 1, tag 2 and priority 0")
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerRestoreArchivedFeature(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery("SELECT feature_id, tag_ids, priority, deleted_at FROM banners WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"feature_id", "tag_ids", "priority", "deleted_at"}).AddRow(1, "{2}", 0, time.Now()))
+	db_mock.ExpectQuery("SELECT archived FROM features WHERE id = $1 FOR SHARE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"archived"}).AddRow(true))
+	db_mock.ExpectQuery("SELECT id, archived FROM tags WHERE id = ANY($1::int[]) FOR SHARE").
+		WithArgs(pq.Array([]int{2})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "archived"}).AddRow(2, false))
+	db_mock.ExpectRollback()
+
+	rec := postBannerRestore(t, wrapper)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "feature 1 is archived")
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	db, db_mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	db_mock.ExpectExec("DELETE FROM banners WHERE deleted_at < now() - make_interval(secs => $1)").
+		WithArgs(float64(86400)).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+
+	purged, err := purgeTrash(db, 24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), purged)
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+// Result of user banner lookup for one feature and tag pair
+type userBannerEntry struct {
+	content  []byte
+	isActive bool
+}
+
+func (s *Server) PostUserBannerBatch(ctx echo.Context, params PostUserBannerBatchParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+
+	data, err := readRequestBody(ctx.Request().Body)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	request, fieldErrs := decodeUserBannerBatch(data)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+
+	useLastRevision := request.UseLastRevision != nil && *request.UseLastRevision
+	results, err := s.lookupUserBanners(*params.Token, request.Items, useLastRevision)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, UserBannerBatchResponse{Items: results})
+}
+
+// Looks up user banners of several feature and tag pairs like lookupUserBanner does for one pair.
+// Results keep the order of items. Used by REST and gRPC APIs
+func (s *Server) lookupUserBanners(token string, items []UserBannerBatchItem, useLastRevision bool) ([]UserBannerBatchResult, error) {
+	// Same pair may be requested several times, it is looked up once
+	var keys []string
+	pairs := make(map[string]UserBannerBatchItem)
+	for _, item := range items {
+		key := userBannerCacheKey(item.FeatureId, item.TagId)
+
+		if _, ok := pairs[key]; !ok {
+			keys = append(keys, key)
+			pairs[key] = item
+		}
+	}
+
+	entries := make(map[string]userBannerEntry, len(keys))
+	if !useLastRevision {
+		var err error
+		entries, err = s.getCachedUserBanners(keys)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var missed []UserBannerBatchItem
+	for _, key := range keys {
+		if _, ok := entries[key]; !ok {
+			missed = append(missed, pairs[key])
+		}
+	}
+
+	// Like in GetUserBanner, admins bypassing cache preview last revisions and don't write them to cache
+	preview := useLastRevision && validateAdminToken(token, s.tokens)
+	if len(missed) > 0 {
+		found, err := s.getUserBanners(missed, preview)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !preview {
+			_, err = s.cache.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+				for _, item := range missed {
+					key := userBannerCacheKey(item.FeatureId, item.TagId)
+					entry, ok := found[key]
+
+					if !ok {
+						continue
+					}
+					s.cacheUserBanner(pipe, key, entry.content, entry.isActive, item.TagId, contentETag(entry.content))
+				}
+				return nil
+			})
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for key, entry := range found {
+			entries[key] = entry
+		}
+	}
+
+	results := make([]UserBannerBatchResult, len(items))
+	for i, item := range items {
+		result := UserBannerBatchResult{FeatureId: item.FeatureId, TagId: item.TagId}
+		entry, ok := entries[userBannerCacheKey(item.FeatureId, item.TagId)]
+
+		if !ok {
+			result.Status = http.StatusNotFound
+			result.Error = stringPtr("Баннер не найден")
+		} else if !canViewBanner(token, entry.isActive, s.tokens) {
+			result.Status = http.StatusForbidden
+			result.Error = stringPtr("Пользователь не имеет доступа")
+		} else {
+			content := json.RawMessage(entry.content)
+			result.Status = http.StatusOK
+			result.Content = &content
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// Reads user banners from cache in one pipeline. Entries are decoded like in GetUserBanner
+func (s *Server) getCachedUserBanners(keys []string) (map[string]userBannerEntry, error) {
+	cmds := make([]*redis.SliceCmd, len(keys))
+	_, err := s.cache.Pipelined(s.ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			cmds[i] = pipe.HMGet(s.ctx, key, userBannerCacheFields...)
+		}
+		return nil
+	})
+
+	// Errors of single keys, like entries of older versions, are misses
+	if _, ok := err.(redis.Error); err != nil && !ok {
+		return nil, err
+	}
+
+	entries := make(map[string]userBannerEntry, len(keys))
+	for i, key := range keys {
+		values, err := cmds[i].Result()
+
+		if err != nil {
+			continue
+		}
+
+		entry, ok := decodeCachedUserBanner(values)
+
+		if !ok {
+			continue
+		}
+		entries[key] = userBannerEntry{content: entry.content, isActive: entry.isActive}
+	}
+	return entries, nil
+}
+
+// Looks up banners for all given pairs with one query. Each pair gets the banner GetUserBanner would choose for it,
+// published or, with preview, the last revision
+func (s *Server) getUserBanners(items []UserBannerBatchItem, preview bool) (map[string]userBannerEntry, error) {
+	featureIDs := make([]int, len(items))
+	tagIDs := make([]int, len(items))
+	for i, item := range items {
+		featureIDs[i] = item.FeatureId
+		tagIDs[i] = item.TagId
+	}
+
+	query := `SELECT DISTINCT ON (r.feature_id, r.tag_id) r.feature_id, r.tag_id, b.published_content, b.published_is_active
+	FROM unnest($1::int[], $2::int[]) AS r(feature_id, tag_id)
+	JOIN banners b ON b.published_feature_id = r.feature_id AND b.published_tag_ids @> ARRAY[r.tag_id] AND b.deleted_at IS NULL
+	ORDER BY r.feature_id, r.tag_id, b.published_priority DESC, cardinality(b.published_tag_ids), b.published_at DESC, b.id`
+
+	if preview {
+		query = `SELECT DISTINCT ON (r.feature_id, r.tag_id) r.feature_id, r.tag_id, b.content, b.is_active
+	FROM unnest($1::int[], $2::int[]) AS r(feature_id, tag_id)
+	JOIN banners b ON b.feature_id = r.feature_id AND b.tag_ids @> ARRAY[r.tag_id] AND b.deleted_at IS NULL AND b.state <> 'archived'
+	ORDER BY r.feature_id, r.tag_id, b.priority DESC, cardinality(b.tag_ids), b.updated_at DESC, b.id`
+	}
+	rows, err := s.db.Query(query, pq.Array(featureIDs), pq.Array(tagIDs))
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make(map[string]userBannerEntry, len(items))
+	for rows.Next() {
+		var featureID, tagID int
+		var entry userBannerEntry
+
+		if err := rows.Scan(&featureID, &tagID, &entry.content, &entry.isActive); err != nil {
+			return nil, err
+		}
+
+		entries[userBannerCacheKey(featureID, tagID)] = entry
+	}
+	return entries, rows.Err()
+}
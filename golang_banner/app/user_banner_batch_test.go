@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeUserBannerBatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		fields []FieldError
+	}{
+		{
+			name: "valid",
+			body: `{"items": [{"feature_id": 1, "tag_id": 2}], "use_last_revision": true}`,
+		},
+		{
+			name:   "missing items",
+			body:   `{}`,
+			fields: []FieldError{{Field: "items", Message: "is required"}},
+		},
+		{
+			name:   "empty items",
+			body:   `{"items": []}`,
+			fields: []FieldError{{Field: "items", Message: "must contain at least one item"}},
+		},
+		{
+			name: "wrong pairs",
+			body: `{"items": [{"feature_id": 1}, {"feature_id": "1", "tag_id": 0.5}]}`,
+			fields: []FieldError{
+				{Field: "items[0].tag_id", Message: "is required"},
+				{Field: "items[1].feature_id", Message: "must be a positive integer"},
+				{Field: "items[1].tag_id", Message: "must be a positive integer"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, fields := decodeUserBannerBatch([]byte(test.body))
+			assert.Equal(t, test.fields, fields)
+		})
+	}
+}
+
+func TestUserBannerBatch(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+	cached := []byte(`{"key": "cached"}`)
+	stored := []byte(`{"key": "stored"}`)
+	expectCachedUserBanner(cache_mock, "2:3", cached, true, 3)
+	expectUserBannerCacheMiss(cache_mock, "4:5")
+	expectUserBannerCacheMiss(cache_mock, "6:7")
+	expectCacheUserBanner(cache_mock, "4:5", stored, false, 5)
+	db_mock.ExpectQuery(`SELECT DISTINCT ON (r.feature_id, r.tag_id) r.feature_id, r.tag_id, b.published_content, b.published_is_active
+	FROM unnest($1::int[], $2::int[]) AS r(feature_id, tag_id)
+	JOIN banners b ON b.published_feature_id = r.feature_id AND b.published_tag_ids @> ARRAY[r.tag_id] AND b.deleted_at IS NULL
+	ORDER BY r.feature_id, r.tag_id, b.published_priority DESC, cardinality(b.published_tag_ids), b.published_at DESC, b.id`).
+		WithArgs(pq.Array([]int{4, 6}), pq.Array([]int{5, 7})).
+		WillReturnRows(sqlmock.NewRows([]string{"feature_id", "tag_id", "content", "is_active"}).AddRow(4, 5, stored, false))
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	body := `{"items": [{"feature_id": 2, "tag_id": 3}, {"feature_id": 4, "tag_id": 5}, {"feature_id": 6, "tag_id": 7}, {"feature_id": 2, "tag_id": 3}]}`
+	req := httptest.NewRequest(http.MethodPost, "/user_banner/batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IMACREEP")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostUserBannerBatch(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response UserBannerBatchResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+		if err != nil {
+			t.Fatalf("Error occcured: %s", err.Error())
+		}
+
+		statuses := make([]int, len(response.Items))
+		for i, item := range response.Items {
+			statuses[i] = item.Status
+		}
+
+		assert.Equal(t, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound, http.StatusOK}, statuses)
+		assert.JSONEq(t, string(cached), string(*response.Items[0].Content))
+		assert.Nil(t, response.Items[1].Content)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v8"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserBannerCacheNotModified(t *testing.T) {
+	server, _, cache_mock := newTestServer(t)
+	etag := contentETag([]byte(`{"key": "value"}`))
+	// Content is not read when client has the banner
+	cache_mock.ExpectHMGet("2:3", userBannerHeaderFields...).
+		SetVal(cachedUserBannerValues([]byte(`{"key": "value"}`), true, 3)[:len(userBannerHeaderFields)])
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/user_banner?tag_id=3&feature_id=2", nil)
+	req.Header.Set("token", "IMACREEP")
+	req.Header.Set("If-None-Match", etag)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetUserBanner(c)) {
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Equal(t, etag, rec.Header().Get("ETag"))
+		assert.Equal(t, "private, max-age=300", rec.Header().Get("Cache-Control"))
+		assert.Empty(t, rec.Body.Bytes())
+	}
+
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserBannerCacheModified(t *testing.T) {
+	server, _, cache_mock := newTestServer(t)
+	// Content is read only after ETag of the client turns out stale
+	jsonData := []byte(`{"key": "value"}`)
+	cache_mock.ExpectHMGet("2:3", userBannerHeaderFields...).
+		SetVal(cachedUserBannerValues(jsonData, true, 3)[:len(userBannerHeaderFields)])
+	expectCachedUserBanner(cache_mock, "2:3", jsonData, true, 3)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/user_banner?tag_id=3&feature_id=2", nil)
+	req.Header.Set("token", "IMACREEP")
+	req.Header.Set("If-None-Match", `"stale"`)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetUserBanner(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, string(jsonData), rec.Body.String())
+		assert.Equal(t, contentETag(jsonData), rec.Header().Get("ETag"))
+	}
+
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserBannerDBNotModified(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+	jsonData := []byte(`{"key": "value"}`)
+	etag := contentETag(jsonData)
+	expectCacheUserBanner(cache_mock, "2:3", jsonData, true, 3)
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{3})).
+		WillReturnRows(sqlmock.NewRows([]string{"content", "is_active", "tag"}).AddRow(jsonData, true, 3))
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/user_banner?tag_id=3&feature_id=2&use_last_revision=true", nil)
+	req.Header.Set("token", "IMACREEP")
+	req.Header.Set("If-None-Match", `"stale", `+etag)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetUserBanner(c)) {
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Equal(t, "private, no-cache", rec.Header().Get("Cache-Control"))
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserBannerCacheOldEntry(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+	// Older versions stored strings under the key, reading them as hash fails
+	jsonData := []byte(`{"key": "value"}`)
+	cache_mock.ExpectHMGet("2:3", userBannerCacheFields...).
+		SetErr(errors.New("WRONGTYPE Operation against a key holding the wrong kind of value"))
+	expectCacheUserBanner(cache_mock, "2:3", jsonData, true, 3)
+	db_mock.ExpectQuery(userBannerQuery).
+		WithArgs(2, pq.Array([]int{3})).
+		WillReturnRows(sqlmock.NewRows([]string{"content", "is_active", "tag"}).AddRow(jsonData, true, 3))
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/user_banner?tag_id=3&feature_id=2", nil)
+	req.Header.Set("token", "IMACREEP")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetUserBanner(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, string(jsonData), rec.Body.String())
+		assert.Equal(t, contentETag(jsonData), rec.Header().Get("ETag"))
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// Values of user banner fields as cacheUserBanner stores them
+func cachedUserBannerValues(content []byte, isActive bool, tag int) []interface{} {
+	active := "0"
+
+	if isActive {
+		active = "1"
+	}
+	return []interface{}{contentETag(content), active, strconv.Itoa(tag), string(content)}
+}
+
+// Expects user banner to be read from cache with its content
+func expectCachedUserBanner(cache_mock redismock.ClientMock, key string, content []byte, isActive bool, tag int) {
+	cache_mock.ExpectHMGet(key, userBannerCacheFields...).SetVal(cachedUserBannerValues(content, isActive, tag))
+}
+
+// Expects user banner to be missing in cache
+func expectUserBannerCacheMiss(cache_mock redismock.ClientMock, key string) {
+	cache_mock.ExpectHMGet(key, userBannerCacheFields...).SetVal(make([]interface{}, len(userBannerCacheFields)))
+}
+
+// Expects user banner to be stored to cache
+func expectCacheUserBanner(cache_mock redismock.ClientMock, key string, content []byte, isActive bool, tag int) {
+	cache_mock.ExpectTxPipeline()
+	cache_mock.ExpectDel(key).SetVal(0)
+	cache_mock.ExpectHSet(key, "etag", contentETag(content), "is_active", isActive, "tag", tag, "content", content).SetVal(4)
+	cache_mock.ExpectExpire(key, userBannerCacheTTL).SetVal(true)
+	cache_mock.ExpectTxPipelineExec()
+}
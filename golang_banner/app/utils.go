@@ -1,6 +1,16 @@
 package main
 
-import "fmt"
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
 
 // Checks if token is present in map
 func validateToken(token string, tokens map[string]string) bool {
@@ -16,62 +26,226 @@ func validateAdminToken(token string, tokens map[string]string) bool {
 	return tokens[token] == "admin"
 }
 
-// Parses JSON map and stores data in given parameters. Returns false if some parameteres weren't parsed sucessfully
-func jsonToParams(data map[string]interface{}, content *map[string]interface{}, featureID *int, tagIDs *[]int, isActive *bool) error {
-	var ok bool
-	*content, ok = data["content"].(map[string]interface{})
-	
-	if !ok {
-		return fmt.Errorf("error parsing content field")
+// Checks if user banner can be shown to token owner. Inactive banners are shown only to admins
+func canViewBanner(token string, isActive bool, tokens map[string]string) bool {
+	return isActive || validateAdminToken(token, tokens)
+}
+
+// Returns banner priority, banners without explicit priority get 0
+func bannerPriority(input BannerInput) int {
+	if input.Priority == nil {
+		return 0
 	}
-	
-	float_val, ok := data["feature_id"].(float64)
-	
-	if !ok {
-		return fmt.Errorf("error parsing feature_id field")
+	return *input.Priority
+}
+
+// Returns pointer to copy of given string
+func stringPtr(value string) *string {
+	return &value
+}
+
+// Columns of banners table in the order scanBanner reads them
+const bannerColumns = "id, tag_ids, feature_id, content, is_active, created_at, updated_at, revision, priority, deleted_at, state, published_revision, published_at, review_comment"
+
+// Common interface of sql.Row and sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Reads banner from row selected with bannerColumns
+func scanBanner(row rowScanner) (Banner, error) {
+	var banner Banner
+	err := row.Scan(&banner.ID, pq.Array(&banner.TagIDs), &banner.FeatureID, &banner.Content, &banner.IsActive, &banner.CreatedAt, &banner.UpdatedAt, &banner.Revision, &banner.Priority, &banner.DeletedAt, &banner.State, &banner.PublishedRevision, &banner.PublishedAt, &banner.ReviewComment)
+	return banner, err
+}
+
+// Builds ETag header value from banner revision
+func bannerETag(revision int64) string {
+	return fmt.Sprintf("\"%d\"", revision)
+}
+
+// Builds cache key of user banner. Tags keep request order, because it decides which tag is reported as matched
+func userBannerCacheKey(featureID int, tagIDs ...int) string {
+	tags := make([]string, len(tagIDs))
+	for i, tagID := range tagIDs {
+		tags[i] = strconv.Itoa(tagID)
 	}
-	
-	*featureID = int(float_val)
-	ifaceSlice, ok := data["tag_ids"].([]interface{})
-	
-	if ok {
-		*tagIDs = make([]int, len(ifaceSlice))
-		for i, v := range ifaceSlice {
-			(*tagIDs)[i] = int(v.(float64))
+	return fmt.Sprintf("%d:%s", featureID, strings.Join(tags, ","))
+}
+
+// Removes repeated ids keeping order of first occurrences
+func uniqueIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
 		}
-	} else {
-		return fmt.Errorf("error parsing tag_ids field")
 	}
-	
-	*isActive, ok = data["is_active"].(bool)
-	
-	if !ok {
-		return fmt.Errorf("error parsing is_active field")
+	return unique
+}
+
+// Selects the best published banner of feature $1 for user tags $2. Banners are ordered by priority, then by number of matched tags,
+// then by number of banner tags, so the most specific banner wins, then by publication time and id.
+// Matched tag is the first tag from $2 the banner has
+const userBannerQuery = `SELECT published_content, published_is_active,
+	(SELECT t FROM unnest($2::int[]) WITH ORDINALITY AS u(t, n) WHERE t = ANY(published_tag_ids) ORDER BY n LIMIT 1)
+	FROM banners
+	WHERE published_feature_id = $1 AND published_tag_ids && $2::int[] AND deleted_at IS NULL
+	ORDER BY published_priority DESC,
+	(SELECT count(*) FROM unnest(published_tag_ids) AS t WHERE t = ANY($2::int[])) DESC,
+	cardinality(published_tag_ids),
+	published_at DESC,
+	id
+	LIMIT 1`
+
+// Same as userBannerQuery, but selects the last revision of banners, so admins can preview drafts. Archived banners are skipped
+const previewUserBannerQuery = `SELECT content, is_active,
+	(SELECT t FROM unnest($2::int[]) WITH ORDINALITY AS u(t, n) WHERE t = ANY(tag_ids) ORDER BY n LIMIT 1)
+	FROM banners
+	WHERE feature_id = $1 AND tag_ids && $2::int[] AND deleted_at IS NULL AND state <> 'archived'
+	ORDER BY priority DESC,
+	(SELECT count(*) FROM unnest(tag_ids) AS t WHERE t = ANY($2::int[])) DESC,
+	cardinality(tag_ids),
+	updated_at DESC,
+	id
+	LIMIT 1`
+
+// Builds ETag header value from user banner content
+func contentETag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return fmt.Sprintf("\"%x\"", sum[:8])
+}
+
+// Checks if etag is listed in If-Match or If-None-Match header value. "*" matches any etag, weak validators are compared by their opaque part
+func etagMatches(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
 	}
-	return nil
+	return false
 }
 
-// Wrapper function for building params for getBanner query
-func getBannerQueryBuilder(params GetBannerParams) (string, []interface{}) {
-	query := "SELECT * FROM banners WHERE 1=1"
+// Wrapper function for building filters shared by getBanner and countBanners queries. Deleted banners are never listed
+func bannerFilterBuilder(params GetBannerParams) (string, []interface{}) {
+	query := " WHERE deleted_at IS NULL"
 	args := []interface{}{}
 	count := 1
 	
-	if params.FeatureId != nil {
-		query += fmt.Sprintf(" AND feature_id = $%d", count)
-		args = append(args, *params.FeatureId)
+	if params.FeatureId != nil && len(*params.FeatureId) > 0 {
+		query += fmt.Sprintf(" AND feature_id = ANY($%d::int[])", count)
+		args = append(args, pq.Array(*params.FeatureId))
 		count++
 	}
 	
-	if params.TagId != nil {
-		query += fmt.Sprintf(" AND $%d = ANY(tag_ids)", count)
-		args = append(args, *params.TagId)
+	if params.TagId != nil && len(*params.TagId) > 0 {
+		operator := "&&"
+
+		if params.TagMatch != nil && *params.TagMatch == GetBannerParamsTagMatchAll {
+			operator = "@>"
+		}
+		query += fmt.Sprintf(" AND tag_ids %s $%d::int[]", operator, count)
+		args = append(args, pq.Array(*params.TagId))
+		count++
+	}
+
+	if params.IsActive != nil {
+		query += fmt.Sprintf(" AND is_active = $%d", count)
+		args = append(args, *params.IsActive)
 		count++
 	}
+
+	if params.State != nil {
+		query += fmt.Sprintf(" AND state = $%d", count)
+		args = append(args, string(*params.State))
+		count++
+	}
+
+	// Timestamps are stored without time zone in UTC
+	ranges := []struct {
+		condition string
+		value     *time.Time
+	}{
+		{"created_at >=", params.CreatedFrom},
+		{"created_at <", params.CreatedTo},
+		{"updated_at >=", params.UpdatedFrom},
+		{"updated_at <", params.UpdatedTo},
+	}
+	for _, r := range ranges {
+		if r.value != nil {
+			query += fmt.Sprintf(" AND %s $%d", r.condition, count)
+			args = append(args, r.value.UTC())
+			count++
+		}
+	}
+
+	if params.Q != nil && *params.Q != "" {
+		query += fmt.Sprintf(" AND to_tsvector('simple', content) @@ plainto_tsquery('simple', $%d)", count)
+		args = append(args, *params.Q)
+		count++
+	}
+
+	if params.ContentPath != nil && *params.ContentPath != "" {
+		query += fmt.Sprintf(" AND content @? $%d::jsonpath", count)
+		args = append(args, *params.ContentPath)
+		count++
+	}
+	return query, args
+}
+
+// Checks if query failed because content_path is not a valid SQL/JSONPath expression
+func isContentPathError(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && (pqErr.Code == "42601" || pqErr.Code == "2201B")
+}
+
+// Wrapper function for building params for countBanners query
+func countBannersQueryBuilder(params GetBannerParams) (string, []interface{}) {
+	filter, args := bannerFilterBuilder(params)
+	return "SELECT count(*) FROM banners" + filter, args
+}
+
+// Wrapper function for building params for getBanner query. Banners are always ordered by sort column and id, so pages are stable.
+// With cursor only banners after it are selected. One banner more than limit is fetched to find out if there is a next page
+func getBannerQueryBuilder(params GetBannerParams, sort GetBannerParamsSort, order GetBannerParamsOrder, cursor *bannerCursor) (string, []interface{}) {
+	filter, args := bannerFilterBuilder(params)
+	query := "SELECT " + bannerColumns + " FROM banners" + filter
+	count := len(args) + 1
+	column := bannerSortColumns[sort]
+	direction, comparison := "ASC", ">"
+
+	if order == Desc {
+		direction, comparison = "DESC", "<"
+	}
+	
+	if cursor != nil {
+		// Value was checked by decodeBannerCursor
+		value, _ := cursor.value()
+
+		if value == nil {
+			query += fmt.Sprintf(" AND id %s $%d", comparison, count)
+			args = append(args, cursor.ID)
+			count++
+		} else {
+			query += fmt.Sprintf(" AND (%s, id) %s ($%d, $%d)", column, comparison, count, count+1)
+			args = append(args, value, cursor.ID)
+			count += 2
+		}
+	}
+
+	if column == "id" {
+		query += " ORDER BY id " + direction
+	} else {
+		query += fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+	}
 	
 	if params.Limit != nil {
 		query += fmt.Sprintf(" LIMIT $%d", count)
-		args = append(args, *params.Limit)
+		args = append(args, *params.Limit+1)
 		count++
 	}
 	
@@ -82,3 +256,45 @@ func getBannerQueryBuilder(params GetBannerParams) (string, []interface{}) {
 	}
 	return query, args
 }
+
+// Wrapper function for building params for patchBanner query. Only given fields are updated and only if banner still has given revision
+func patchBannerQueryBuilder(id int, revision int64, fields []string, input BannerInput, contentJSON []byte) (string, []interface{}) {
+	query := "UPDATE banners SET "
+	args := []interface{}{}
+	count := 1
+
+	for i, field := range fields {
+		if i > 0 {
+			query += ", "
+		}
+		query += fmt.Sprintf("%s = $%d", field, count)
+		switch field {
+		case "tag_ids":
+			args = append(args, pq.Array(input.TagIds))
+		case "feature_id":
+			args = append(args, input.FeatureId)
+		case "content":
+			args = append(args, contentJSON)
+		case "is_active":
+			args = append(args, input.IsActive)
+		case "priority":
+			args = append(args, bannerPriority(input))
+		}
+		count++
+	}
+
+	// Any change has to be reviewed again, users keep getting the published revision meanwhile
+	query += fmt.Sprintf(", state = 'draft' WHERE id = $%d AND revision = $%d RETURNING revision", count, count+1)
+	args = append(args, id, revision)
+	return query, args
+}
+
+// Reads duration from environment variable, falls back to def if variable is empty or malformed
+func durationFromEnv(name string, def time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(name))
+
+	if err != nil || value <= 0 {
+		return def
+	}
+	return value
+}
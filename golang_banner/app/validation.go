@@ -0,0 +1,768 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits for request bodies
+const (
+	maxTagsPerBanner = 100
+	maxContentSize   = 64 * 1024
+	maxRequestSize   = 2 * maxContentSize
+	maxBatchItems    = 50
+	maxBulkItems     = 1000
+	// Bulk requests carry many banners, so they get a bigger limit
+	maxBulkRequestSize = 16 * 1024 * 1024
+	maxFilterItems     = 100
+	maxSearchLength    = 256
+	maxContentPath     = 1024
+	maxCatalogName     = 128
+	maxCatalogText     = 1024
+	maxReviewComment   = 1024
+	maxWebhookURL      = 2048
+	minWebhookSecret   = 16
+	maxWebhookSecret   = 256
+)
+
+// Fields of BannerInput in the order they are reported
+var bannerInputFields = []string{"tag_ids", "feature_id", "content", "is_active", "priority"}
+
+// Fields of BannerInput that may be omitted
+var optionalBannerInputFields = map[string]bool{"priority": true}
+
+// Reads request body up to maxRequestSize. Returns an error if the body is bigger
+func readRequestBody(body io.Reader) ([]byte, error) {
+	return readRequestBodyLimit(body, maxRequestSize)
+}
+
+// Reads request body up to limit bytes. Returns an error if the body is bigger
+func readRequestBodyLimit(body io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(body, limit+1))
+
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("request body exceeds %d bytes", limit)
+	}
+	return data, nil
+}
+
+// Builds response body for failed validation
+func validationError(fields []FieldError) ValidationError {
+	return ValidationError{
+		Error:  "invalid request body",
+		Fields: fields,
+	}
+}
+
+// Decodes banner create request. Every field is decoded on its own, so all wrong fields are reported and not only the first one
+func decodeBannerInput(data []byte) (BannerInput, []FieldError) {
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return BannerInput{}, []FieldError{{Field: "body", Message: "must be a JSON object"}}
+	}
+	return decodeBannerFields(raw)
+}
+
+// Decodes banner fields of already parsed JSON object
+func decodeBannerFields(raw map[string]json.RawMessage) (BannerInput, []FieldError) {
+	var input BannerInput
+	var errs []FieldError
+	for _, field := range bannerInputFields {
+		value, ok := raw[field]
+
+		if !ok {
+			if !optionalBannerInputFields[field] {
+				errs = append(errs, FieldError{Field: field, Message: "is required"})
+			}
+			continue
+		}
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			errs = append(errs, FieldError{Field: field, Message: "must not be null"})
+			continue
+		}
+
+		switch field {
+		case "tag_ids":
+			input.TagIds, errs = decodeTagIDs(value, errs)
+		case "feature_id":
+			input.FeatureId, errs = decodeID(field, value, errs)
+		case "content":
+			input.Content, errs = decodeContent(value, errs)
+		case "is_active":
+			if err := json.Unmarshal(value, &input.IsActive); err != nil {
+				errs = append(errs, FieldError{Field: field, Message: "must be a boolean"})
+			}
+		case "priority":
+			var priority int
+
+			if err := json.Unmarshal(value, &priority); err != nil || priority < math.MinInt32 || priority > math.MaxInt32 {
+				errs = append(errs, FieldError{Field: field, Message: "must be a 32-bit integer"})
+			} else {
+				input.Priority = &priority
+			}
+		}
+	}
+
+	errs = append(errs, rejectUnknownFields(raw, bannerInputFields...)...)
+	return input, errs
+}
+
+// Reports fields of JSON object that are not allowed. Fields are sorted, so errors come in the same order every time
+func rejectUnknownFields(raw map[string]json.RawMessage, allowed ...string) []FieldError {
+	known := make(map[string]bool, len(allowed))
+	for _, field := range allowed {
+		known[field] = true
+	}
+
+	var unknown []string
+	for field := range raw {
+		if !known[field] {
+			unknown = append(unknown, field)
+		}
+	}
+	sort.Strings(unknown)
+
+	var errs []FieldError
+	for _, field := range unknown {
+		errs = append(errs, FieldError{Field: field, Message: "unknown field"})
+	}
+	return errs
+}
+
+// Decodes positive integer identifier. Fractions, strings and numbers in exponent form are rejected
+func decodeID(field string, value json.RawMessage, errs []FieldError) (int, []FieldError) {
+	var id int
+
+	if err := json.Unmarshal(value, &id); err != nil || id <= 0 {
+		return 0, append(errs, FieldError{Field: field, Message: "must be a positive integer"})
+	}
+	return id, errs
+}
+
+// Decodes tag list and checks its size and uniqueness of tags
+func decodeTagIDs(value json.RawMessage, errs []FieldError) ([]int, []FieldError) {
+	var items []json.RawMessage
+
+	if err := json.Unmarshal(value, &items); err != nil {
+		return nil, append(errs, FieldError{Field: "tag_ids", Message: "must be an array of integers"})
+	}
+	if len(items) == 0 {
+		return nil, append(errs, FieldError{Field: "tag_ids", Message: "must contain at least one tag"})
+	}
+	if len(items) > maxTagsPerBanner {
+		return nil, append(errs, FieldError{Field: "tag_ids", Message: fmt.Sprintf("must contain at most %d tags", maxTagsPerBanner)})
+	}
+
+	tagIDs := make([]int, 0, len(items))
+	seen := make(map[int]bool, len(items))
+	for i, item := range items {
+		field := fmt.Sprintf("tag_ids[%d]", i)
+		var id int
+		id, errs = decodeID(field, item, errs)
+
+		if id == 0 {
+			continue
+		}
+		if seen[id] {
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("duplicate tag %d", id)})
+			continue
+		}
+		seen[id] = true
+		tagIDs = append(tagIDs, id)
+	}
+	return tagIDs, errs
+}
+
+// Decodes banner content. Content must be a JSON object no bigger than maxContentSize
+func decodeContent(value json.RawMessage, errs []FieldError) (map[string]interface{}, []FieldError) {
+	if len(value) > maxContentSize {
+		return nil, append(errs, FieldError{Field: "content", Message: fmt.Sprintf("must not exceed %d bytes", maxContentSize)})
+	}
+
+	var content map[string]interface{}
+
+	if err := json.Unmarshal(value, &content); err != nil {
+		return nil, append(errs, FieldError{Field: "content", Message: "must be a JSON object"})
+	}
+	return content, errs
+}
+
+// Checks banner fields that are already typed, like the ones of gRPC requests. Reports the same errors as decodeBannerInput
+func validateBannerInput(input BannerInput) []FieldError {
+	var errs []FieldError
+
+	switch {
+	case len(input.TagIds) == 0:
+		errs = append(errs, FieldError{Field: "tag_ids", Message: "must contain at least one tag"})
+	case len(input.TagIds) > maxTagsPerBanner:
+		errs = append(errs, FieldError{Field: "tag_ids", Message: fmt.Sprintf("must contain at most %d tags", maxTagsPerBanner)})
+	default:
+		seen := make(map[int]bool, len(input.TagIds))
+		for i, id := range input.TagIds {
+			field := fmt.Sprintf("tag_ids[%d]", i)
+
+			if id <= 0 {
+				errs = append(errs, FieldError{Field: field, Message: "must be a positive integer"})
+			} else if seen[id] {
+				errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("duplicate tag %d", id)})
+			}
+			seen[id] = true
+		}
+	}
+	if input.FeatureId <= 0 {
+		errs = append(errs, FieldError{Field: "feature_id", Message: "must be a positive integer"})
+	}
+	if input.Content == nil {
+		errs = append(errs, FieldError{Field: "content", Message: "is required"})
+	} else if data, err := json.Marshal(input.Content); err != nil || len(data) > maxContentSize {
+		errs = append(errs, FieldError{Field: "content", Message: fmt.Sprintf("must not exceed %d bytes", maxContentSize)})
+	}
+	return errs
+}
+
+// Decodes user banner batch request. Pairs are reported by their position, for example items[2].tag_id
+func decodeUserBannerBatch(data []byte) (UserBannerBatchRequest, []FieldError) {
+	var request UserBannerBatchRequest
+	var raw struct {
+		Items           json.RawMessage `json:"items"`
+		UseLastRevision json.RawMessage `json:"use_last_revision"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return request, []FieldError{{Field: "body", Message: "must be a JSON object"}}
+	}
+
+	var errs []FieldError
+	if raw.UseLastRevision != nil {
+		var useLastRevision bool
+
+		if err := json.Unmarshal(raw.UseLastRevision, &useLastRevision); err != nil {
+			errs = append(errs, FieldError{Field: "use_last_revision", Message: "must be a boolean"})
+		}
+		request.UseLastRevision = &useLastRevision
+	}
+
+	var items []map[string]json.RawMessage
+
+	if raw.Items == nil {
+		return request, append(errs, FieldError{Field: "items", Message: "is required"})
+	}
+	if err := json.Unmarshal(raw.Items, &items); err != nil {
+		return request, append(errs, FieldError{Field: "items", Message: "must be an array of objects"})
+	}
+	if len(items) == 0 {
+		return request, append(errs, FieldError{Field: "items", Message: "must contain at least one item"})
+	}
+	if len(items) > maxBatchItems {
+		return request, append(errs, FieldError{Field: "items", Message: fmt.Sprintf("must contain at most %d items", maxBatchItems)})
+	}
+
+	request.Items = make([]UserBannerBatchItem, len(items))
+	for i, item := range items {
+		for _, field := range []string{"feature_id", "tag_id"} {
+			name := fmt.Sprintf("items[%d].%s", i, field)
+			value, ok := item[field]
+
+			if !ok {
+				errs = append(errs, FieldError{Field: name, Message: "is required"})
+				continue
+			}
+
+			var id int
+			id, errs = decodeID(name, value, errs)
+
+			if field == "feature_id" {
+				request.Items[i].FeatureId = id
+			} else {
+				request.Items[i].TagId = id
+			}
+		}
+	}
+	return request, errs
+}
+
+// Checks pairs of user banner batch that are already typed, like the ones of gRPC requests. Reports the same errors as decodeUserBannerBatch
+func validateUserBannerBatch(items []UserBannerBatchItem) []FieldError {
+	if len(items) == 0 {
+		return []FieldError{{Field: "items", Message: "must contain at least one item"}}
+	}
+	if len(items) > maxBatchItems {
+		return []FieldError{{Field: "items", Message: fmt.Sprintf("must contain at most %d items", maxBatchItems)}}
+	}
+
+	var errs []FieldError
+	for i, item := range items {
+		if item.FeatureId <= 0 {
+			errs = append(errs, FieldError{Field: fmt.Sprintf("items[%d].feature_id", i), Message: "must be a positive integer"})
+		}
+		if item.TagId <= 0 {
+			errs = append(errs, FieldError{Field: fmt.Sprintf("items[%d].tag_id", i), Message: "must be a positive integer"})
+		}
+	}
+	return errs
+}
+
+// Checks filters of admin banner list
+func validateBannerFilters(params GetBannerParams) []FieldError {
+	var errs []FieldError
+
+	if params.FeatureId != nil && len(*params.FeatureId) > maxFilterItems {
+		errs = append(errs, FieldError{Field: "feature_id", Message: fmt.Sprintf("must contain at most %d features", maxFilterItems)})
+	}
+	if params.TagId != nil && len(*params.TagId) > maxFilterItems {
+		errs = append(errs, FieldError{Field: "tag_id", Message: fmt.Sprintf("must contain at most %d tags", maxFilterItems)})
+	}
+	if params.TagMatch != nil && *params.TagMatch != GetBannerParamsTagMatchAny && *params.TagMatch != GetBannerParamsTagMatchAll {
+		errs = append(errs, FieldError{Field: "tag_match", Message: "must be any or all"})
+	}
+	if params.State != nil && !bannerStates[string(*params.State)] {
+		errs = append(errs, FieldError{Field: "state", Message: "must be draft, pending_review, approved, published or archived"})
+	}
+	if params.CreatedFrom != nil && params.CreatedTo != nil && !params.CreatedFrom.Before(*params.CreatedTo) {
+		errs = append(errs, FieldError{Field: "created_to", Message: "must be after created_from"})
+	}
+	if params.UpdatedFrom != nil && params.UpdatedTo != nil && !params.UpdatedFrom.Before(*params.UpdatedTo) {
+		errs = append(errs, FieldError{Field: "updated_to", Message: "must be after updated_from"})
+	}
+	if params.Q != nil && len(*params.Q) > maxSearchLength {
+		errs = append(errs, FieldError{Field: "q", Message: fmt.Sprintf("must not exceed %d bytes", maxSearchLength)})
+	}
+	if params.ContentPath != nil && len(*params.ContentPath) > maxContentPath {
+		errs = append(errs, FieldError{Field: "content_path", Message: fmt.Sprintf("must not exceed %d bytes", maxContentPath)})
+	}
+	return errs
+}
+
+// Decoded item of bulk request. Items with errs are not applied
+type bannerBulkItem struct {
+	op      BannerBulkItemOp
+	id      int
+	ifMatch *string
+	input   BannerInput
+	patch   []byte
+	errs    []FieldError
+}
+
+// Decodes bulk banner request. Errors of the request itself are returned, errors of items are stored in the items
+func decodeBannerBulk(data []byte) (BannerBulkRequestMode, []bannerBulkItem, []FieldError) {
+	mode := Atomic
+	var raw struct {
+		Mode  json.RawMessage `json:"mode"`
+		Items json.RawMessage `json:"items"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return mode, nil, []FieldError{{Field: "body", Message: "must be a JSON object"}}
+	}
+
+	var errs []FieldError
+	if raw.Mode != nil {
+		if err := json.Unmarshal(raw.Mode, &mode); err != nil || (mode != Atomic && mode != BestEffort) {
+			errs = append(errs, FieldError{Field: "mode", Message: "must be atomic or best_effort"})
+		}
+	}
+
+	var rawItems []map[string]json.RawMessage
+
+	if raw.Items == nil {
+		return mode, nil, append(errs, FieldError{Field: "items", Message: "is required"})
+	}
+	if err := json.Unmarshal(raw.Items, &rawItems); err != nil {
+		return mode, nil, append(errs, FieldError{Field: "items", Message: "must be an array of objects"})
+	}
+	if len(rawItems) == 0 {
+		return mode, nil, append(errs, FieldError{Field: "items", Message: "must contain at least one item"})
+	}
+	if len(rawItems) > maxBulkItems {
+		return mode, nil, append(errs, FieldError{Field: "items", Message: fmt.Sprintf("must contain at most %d items", maxBulkItems)})
+	}
+
+	items := make([]bannerBulkItem, len(rawItems))
+	for i, rawItem := range rawItems {
+		items[i] = decodeBannerBulkItem(rawItem)
+	}
+	return mode, items, errs
+}
+
+// Decodes one bulk item. Banner of create item passes the same validation as POST /banner,
+// banner of patch item is validated against the stored banner when it is applied
+func decodeBannerBulkItem(raw map[string]json.RawMessage) bannerBulkItem {
+	var item bannerBulkItem
+
+	if err := json.Unmarshal(raw["op"], &item.op); err != nil || (item.op != Create && item.op != Patch) {
+		item.errs = append(item.errs, FieldError{Field: "op", Message: "must be create or patch"})
+	}
+
+	banner, ok := raw["banner"]
+	if !ok || bytes.Equal(bytes.TrimSpace(banner), []byte("null")) {
+		item.errs = append(item.errs, FieldError{Field: "banner", Message: "is required"})
+	}
+
+	item.errs = append(item.errs, rejectUnknownFields(raw, "op", "banner", "id", "if_match")...)
+	sort.Slice(item.errs, func(i, j int) bool { return item.errs[i].Field < item.errs[j].Field })
+
+	switch item.op {
+	case Create:
+		if _, ok := raw["id"]; ok {
+			item.errs = append(item.errs, FieldError{Field: "id", Message: "is allowed only for patch"})
+		}
+		if _, ok := raw["if_match"]; ok {
+			item.errs = append(item.errs, FieldError{Field: "if_match", Message: "is allowed only for patch"})
+		}
+		if banner != nil {
+			var fieldErrs []FieldError
+			item.input, fieldErrs = decodeBannerInput(banner)
+
+			for _, fieldErr := range fieldErrs {
+				fieldErr.Field = "banner." + fieldErr.Field
+				item.errs = append(item.errs, fieldErr)
+			}
+		}
+	case Patch:
+		if value, ok := raw["id"]; ok {
+			item.id, item.errs = decodeID("id", value, item.errs)
+		} else {
+			item.errs = append(item.errs, FieldError{Field: "id", Message: "is required"})
+		}
+		if value, ok := raw["if_match"]; ok {
+			var ifMatch string
+
+			if err := json.Unmarshal(value, &ifMatch); err != nil {
+				item.errs = append(item.errs, FieldError{Field: "if_match", Message: "must be a string"})
+			} else {
+				item.ifMatch = &ifMatch
+			}
+		}
+		item.patch = banner
+	}
+	return item
+}
+
+// Fields GET /banner/export writes that POST /banner/import ignores
+var bannerExportOnlyFields = []string{"revision", "created_at", "updated_at", "deleted_at", "state", "published_revision", "published_at", "review_comment"}
+
+// Decoded record of banner import. Records without id have zero id, records with errs are not applied
+type bannerImportRecord struct {
+	line  int
+	id    int
+	input BannerInput
+	errs  []FieldError
+}
+
+// Decodes banner import record. Record passes the same validation as POST /banner body, it may also carry id
+func decodeImportRecord(line int, raw map[string]json.RawMessage) bannerImportRecord {
+	record := bannerImportRecord{line: line}
+
+	if value, ok := raw["id"]; ok {
+		record.id, record.errs = decodeID("id", value, record.errs)
+		delete(raw, "id")
+	}
+	for _, field := range bannerExportOnlyFields {
+		delete(raw, field)
+	}
+
+	var errs []FieldError
+	record.input, errs = decodeBannerFields(raw)
+	record.errs = append(record.errs, errs...)
+	return record
+}
+
+// Fields of FeatureInput and TagInput in the order they are reported
+var catalogInputFields = []string{"name", "description", "owner", "archived"}
+
+// Decoded body of feature or tag create and update requests. Nil fields were not sent
+type catalogInput struct {
+	name        *string
+	description *string
+	owner       *string
+	archived    *bool
+}
+
+// Decodes feature or tag body. Create requires name, update accepts any subset of fields
+func decodeCatalogInput(data []byte, create bool) (catalogInput, []FieldError) {
+	var input catalogInput
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return input, []FieldError{{Field: "body", Message: "must be a JSON object"}}
+	}
+
+	var errs []FieldError
+	for _, field := range catalogInputFields {
+		value, ok := raw[field]
+
+		if !ok {
+			if create && field == "name" {
+				errs = append(errs, FieldError{Field: field, Message: "is required"})
+			}
+			continue
+		}
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			errs = append(errs, FieldError{Field: field, Message: "must not be null"})
+			continue
+		}
+
+		switch field {
+		case "name":
+			input.name, errs = decodeCatalogText(field, value, maxCatalogName, errs)
+
+			if input.name != nil {
+				*input.name = strings.TrimSpace(*input.name)
+
+				if *input.name == "" {
+					errs = append(errs, FieldError{Field: field, Message: "must not be blank"})
+				}
+			}
+		case "description":
+			input.description, errs = decodeCatalogText(field, value, maxCatalogText, errs)
+		case "owner":
+			input.owner, errs = decodeCatalogText(field, value, maxCatalogName, errs)
+		case "archived":
+			var archived bool
+
+			if err := json.Unmarshal(value, &archived); err != nil {
+				errs = append(errs, FieldError{Field: field, Message: "must be a boolean"})
+			} else {
+				input.archived = &archived
+			}
+		}
+	}
+
+	errs = append(errs, rejectUnknownFields(raw, catalogInputFields...)...)
+	return input, errs
+}
+
+// Decodes string field of feature or tag no longer than limit characters
+func decodeCatalogText(field string, value json.RawMessage, limit int, errs []FieldError) (*string, []FieldError) {
+	var text string
+
+	if err := json.Unmarshal(value, &text); err != nil {
+		return nil, append(errs, FieldError{Field: field, Message: "must be a string"})
+	}
+	if utf8.RuneCountInString(text) > limit {
+		return nil, append(errs, FieldError{Field: field, Message: fmt.Sprintf("must not exceed %d characters", limit)})
+	}
+	return &text, errs
+}
+
+// Checks feature or tag fields that are already typed, like the ones of gRPC requests. Trims name and reports the same errors as decodeCatalogInput
+func validateCatalogInput(input *catalogInput, create bool) []FieldError {
+	var errs []FieldError
+	if input.name == nil && create {
+		errs = append(errs, FieldError{Field: "name", Message: "is required"})
+	}
+	for _, field := range []struct {
+		name  string
+		value **string
+		limit int
+	}{
+		{"name", &input.name, maxCatalogName},
+		{"description", &input.description, maxCatalogText},
+		{"owner", &input.owner, maxCatalogName},
+	} {
+		if *field.value == nil {
+			continue
+		}
+		if utf8.RuneCountInString(**field.value) > field.limit {
+			*field.value = nil
+			errs = append(errs, FieldError{Field: field.name, Message: fmt.Sprintf("must not exceed %d characters", field.limit)})
+		}
+	}
+	if input.name != nil {
+		name := strings.TrimSpace(*input.name)
+		input.name = &name
+
+		if name == "" {
+			errs = append(errs, FieldError{Field: "name", Message: "must not be blank"})
+		}
+	}
+	return errs
+}
+
+// Trims review comment, blank comment means no comment. Reports comment that is too long or missing when it is required
+func checkReviewComment(comment *string, requireComment bool) (*string, []FieldError) {
+	if comment != nil && utf8.RuneCountInString(*comment) > maxReviewComment {
+		return nil, []FieldError{{Field: "comment", Message: fmt.Sprintf("must not exceed %d characters", maxReviewComment)}}
+	}
+	if comment != nil {
+		trimmed := strings.TrimSpace(*comment)
+		comment = &trimmed
+
+		if trimmed == "" {
+			comment = nil
+		}
+	}
+	if requireComment && comment == nil {
+		return nil, []FieldError{{Field: "comment", Message: "is required"}}
+	}
+	return comment, nil
+}
+
+// Decodes body of approve or reject request. Empty body and blank comment mean no comment
+func decodeReviewDecision(data []byte, requireComment bool) (ReviewDecision, []FieldError) {
+	var decision ReviewDecision
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		if requireComment {
+			return decision, []FieldError{{Field: "comment", Message: "is required"}}
+		}
+		return decision, nil
+	}
+
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return decision, []FieldError{{Field: "body", Message: "must be a JSON object"}}
+	}
+
+	var errs []FieldError
+	if value, ok := raw["comment"]; ok && !bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+		decision.Comment, errs = decodeCatalogText("comment", value, maxReviewComment, errs)
+	}
+	if len(errs) == 0 {
+		decision.Comment, errs = checkReviewComment(decision.Comment, requireComment)
+	}
+
+	errs = append(errs, rejectUnknownFields(raw, "comment")...)
+	return decision, errs
+}
+
+// Fields of WebhookInput in the order they are reported
+var webhookInputFields = []string{"url", "events", "secret", "active"}
+
+// Decoded body of webhook create and update requests. Nil fields were not sent
+type webhookInput struct {
+	url    *string
+	events []string
+	secret *string
+	active *bool
+}
+
+// Decodes webhook body. Create requires url and events, update accepts any subset of fields
+func decodeWebhookInput(data []byte, create bool) (webhookInput, []FieldError) {
+	var input webhookInput
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return input, []FieldError{{Field: "body", Message: "must be a JSON object"}}
+	}
+
+	var errs []FieldError
+	for _, field := range webhookInputFields {
+		value, ok := raw[field]
+
+		if !ok {
+			if create && (field == "url" || field == "events") {
+				errs = append(errs, FieldError{Field: field, Message: "is required"})
+			}
+			continue
+		}
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			errs = append(errs, FieldError{Field: field, Message: "must not be null"})
+			continue
+		}
+
+		switch field {
+		case "url":
+			input.url, errs = decodeCatalogText(field, value, maxWebhookURL, errs)
+
+			if input.url != nil && !isWebhookURL(*input.url) {
+				input.url = nil
+				errs = append(errs, FieldError{Field: field, Message: "must be an absolute http or https URL"})
+			}
+		case "events":
+			input.events, errs = decodeWebhookEvents(value, errs)
+		case "secret":
+			input.secret, errs = decodeCatalogText(field, value, maxWebhookSecret, errs)
+
+			if input.secret != nil && utf8.RuneCountInString(*input.secret) < minWebhookSecret {
+				input.secret = nil
+				errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("must be at least %d characters", minWebhookSecret)})
+			}
+		case "active":
+			var active bool
+
+			if err := json.Unmarshal(value, &active); err != nil {
+				errs = append(errs, FieldError{Field: field, Message: "must be a boolean"})
+			} else {
+				input.active = &active
+			}
+		}
+	}
+
+	errs = append(errs, rejectUnknownFields(raw, webhookInputFields...)...)
+	return input, errs
+}
+
+// Checks webhook fields that are already typed, like the ones of gRPC requests. Drops duplicate events and reports the same errors as decodeWebhookInput
+func validateWebhookInput(input *webhookInput, create bool) []FieldError {
+	var errs []FieldError
+	if input.url == nil && create {
+		errs = append(errs, FieldError{Field: "url", Message: "is required"})
+	} else if input.url != nil {
+		if utf8.RuneCountInString(*input.url) > maxWebhookURL {
+			errs = append(errs, FieldError{Field: "url", Message: fmt.Sprintf("must not exceed %d characters", maxWebhookURL)})
+		} else if !isWebhookURL(*input.url) {
+			errs = append(errs, FieldError{Field: "url", Message: "must be an absolute http or https URL"})
+		}
+	}
+	if input.events == nil && create {
+		errs = append(errs, FieldError{Field: "events", Message: "is required"})
+	} else if input.events != nil {
+		input.events, errs = checkWebhookEvents(input.events, errs)
+	}
+	if input.secret != nil {
+		if utf8.RuneCountInString(*input.secret) > maxWebhookSecret {
+			errs = append(errs, FieldError{Field: "secret", Message: fmt.Sprintf("must not exceed %d characters", maxWebhookSecret)})
+		} else if utf8.RuneCountInString(*input.secret) < minWebhookSecret {
+			errs = append(errs, FieldError{Field: "secret", Message: fmt.Sprintf("must be at least %d characters", minWebhookSecret)})
+		}
+	}
+	return errs
+}
+
+// Decodes non-empty list of webhook event types. Duplicates are dropped, order is kept
+func decodeWebhookEvents(value json.RawMessage, errs []FieldError) ([]string, []FieldError) {
+	var items []string
+
+	if err := json.Unmarshal(value, &items); err != nil {
+		return nil, append(errs, FieldError{Field: "events", Message: "must be an array of strings"})
+	}
+	return checkWebhookEvents(items, errs)
+}
+
+// Checks that event types are known and drops duplicates, order is kept
+func checkWebhookEvents(items []string, errs []FieldError) ([]string, []FieldError) {
+	if len(items) == 0 {
+		return nil, append(errs, FieldError{Field: "events", Message: "must not be empty"})
+	}
+
+	events := []string{}
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		if !webhookEventTypes[item] {
+			return nil, append(errs, FieldError{Field: fmt.Sprintf("events[%d]", i), Message: "must be created, updated, deleted or activated"})
+		}
+		if !seen[item] {
+			seen[item] = true
+			events = append(events, item)
+		}
+	}
+	return events, errs
+}
+
+func isWebhookURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
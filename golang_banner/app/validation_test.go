@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBannerInput(t *testing.T) {
+	bigContent := fmt.Sprintf(`{"text": "%s"}`, strings.Repeat("a", maxContentSize))
+	manyTags := make([]string, maxTagsPerBanner+1)
+
+	for i := range manyTags {
+		manyTags[i] = fmt.Sprint(i + 1)
+	}
+
+	tests := []struct {
+		name   string
+		body   string
+		fields []FieldError
+	}{
+		{
+			name: "valid",
+			body: `{"tag_ids": [1, 2], "feature_id": 3, "content": {"title": "t"}, "is_active": true}`,
+		},
+		{
+			name: "valid with priority",
+			body: `{"tag_ids": [1, 2], "feature_id": 3, "content": {"title": "t"}, "is_active": true, "priority": -5}`,
+		},
+		{
+			name:   "priority out of range",
+			body:   `{"tag_ids": [1, 2], "feature_id": 3, "content": {"title": "t"}, "is_active": true, "priority": 3000000000}`,
+			fields: []FieldError{{Field: "priority", Message: "must be a 32-bit integer"}},
+		},
+		{
+			name:   "not an object",
+			body:   `[1, 2]`,
+			fields: []FieldError{{Field: "body", Message: "must be a JSON object"}},
+		},
+		{
+			name: "missing fields",
+			body: `{"feature_id": 3}`,
+			fields: []FieldError{
+				{Field: "tag_ids", Message: "is required"},
+				{Field: "content", Message: "is required"},
+				{Field: "is_active", Message: "is required"},
+			},
+		},
+		{
+			name: "string tag and fractional feature",
+			body: `{"tag_ids": [1, "2"], "feature_id": 2.7, "content": {}, "is_active": true}`,
+			fields: []FieldError{
+				{Field: "tag_ids[1]", Message: "must be a positive integer"},
+				{Field: "feature_id", Message: "must be a positive integer"},
+			},
+		},
+		{
+			name: "negative ids",
+			body: `{"tag_ids": [-1], "feature_id": -3, "content": {}, "is_active": true}`,
+			fields: []FieldError{
+				{Field: "tag_ids[0]", Message: "must be a positive integer"},
+				{Field: "feature_id", Message: "must be a positive integer"},
+			},
+		},
+		{
+			name:   "duplicate tags",
+			body:   `{"tag_ids": [1, 2, 1], "feature_id": 3, "content": {}, "is_active": true}`,
+			fields: []FieldError{{Field: "tag_ids[2]", Message: "duplicate tag 1"}},
+		},
+		{
+			name:   "too many tags",
+			body:   `{"tag_ids": [` + strings.Join(manyTags, ",") + `], "feature_id": 3, "content": {}, "is_active": true}`,
+			fields: []FieldError{{Field: "tag_ids", Message: fmt.Sprintf("must contain at most %d tags", maxTagsPerBanner)}},
+		},
+		{
+			name:   "content too large",
+			body:   `{"tag_ids": [1], "feature_id": 3, "content": ` + bigContent + `, "is_active": true}`,
+			fields: []FieldError{{Field: "content", Message: fmt.Sprintf("must not exceed %d bytes", maxContentSize)}},
+		},
+		{
+			name: "wrong types and nulls",
+			body: `{"tag_ids": null, "feature_id": 3, "content": "text", "is_active": "yes", "extra": 1}`,
+			fields: []FieldError{
+				{Field: "tag_ids", Message: "must not be null"},
+				{Field: "content", Message: "must be a JSON object"},
+				{Field: "is_active", Message: "must be a boolean"},
+				{Field: "extra", Message: "unknown field"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, fields := decodeBannerInput([]byte(test.body))
+			assert.Equal(t, test.fields, fields)
+		})
+	}
+}
+
+func TestRejectUnknownFields(t *testing.T) {
+	raw := map[string]json.RawMessage{"name": nil, "zeta": nil, "alpha": nil}
+
+	assert.Equal(t, []FieldError{
+		{Field: "alpha", Message: "unknown field"},
+		{Field: "zeta", Message: "unknown field"},
+	}, rejectUnknownFields(raw, "name", "owner"))
+	assert.Empty(t, rejectUnknownFields(raw, "name", "zeta", "alpha"))
+
+	// Every decoder reports unknown fields the same way
+	_, errs := decodeCatalogInput([]byte(`{"name": "a", "color": "red"}`), true)
+	assert.Equal(t, []FieldError{{Field: "color", Message: "unknown field"}}, errs)
+	_, errs = decodeReviewDecision([]byte(`{"note": "a"}`), false)
+	assert.Equal(t, []FieldError{{Field: "note", Message: "unknown field"}}, errs)
+	item := decodeBannerBulkItem(map[string]json.RawMessage{"op": json.RawMessage(`"patch"`), "id": json.RawMessage(`1`), "banner": json.RawMessage(`{}`), "extra": nil})
+	assert.Equal(t, []FieldError{{Field: "extra", Message: "unknown field"}}, item.errs)
+}
+
+func TestPostBannerValidationError(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	body := `{"tag_ids": [1, "x"], "feature_id": 2, "content": {}, "is_active": true}`
+	req := httptest.NewRequest(http.MethodPost, "/banner", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostBanner(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		var response ValidationError
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+		if err != nil {
+			t.Fatalf("Error occcured: %s", err.Error())
+		}
+
+		assert.Equal(t, []FieldError{{Field: "tag_ids[1]", Message: "must be a positive integer"}}, response.Fields)
+	}
+}
@@ -0,0 +1,474 @@
+package main
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+// Types of banner events webhooks subscribe to
+const (
+	eventCreated   = string(Created)
+	eventUpdated   = string(Updated)
+	eventDeleted   = string(Deleted)
+	eventActivated = string(Activated)
+)
+
+var webhookEventTypes = map[string]bool{
+	eventCreated:   true,
+	eventUpdated:   true,
+	eventDeleted:   true,
+	eventActivated: true,
+}
+
+// Columns of webhooks table in the order scanWebhook reads them. Secret is never selected for responses
+const webhookColumns = "id, url, events, active, created_at, updated_at"
+
+// Columns of delivery joined with its event in the order scanWebhookDelivery reads them
+const webhookDeliveryColumns = "d.id, d.webhook_id, d.status, d.attempts, d.next_attempt_at, d.last_status, d.last_error, d.created_at, d.delivered_at" +
+	", e.id, e.type, e.banner_id, e.changes, e.occurred_at"
+
+func (s *Server) GetWebhooks(ctx echo.Context, params GetWebhooksParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	webhooks, writeErr, err := s.listWebhooks(params.Limit, params.Offset)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusOK, webhooks)
+}
+
+func (s *Server) PostWebhooks(ctx echo.Context, params PostWebhooksParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	data, err := readRequestBody(ctx.Request().Body)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	input, fieldErrs := decodeWebhookInput(data, true)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+
+	webhook, err := s.createWebhook(input)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusCreated, webhook)
+}
+
+func (s *Server) GetWebhooksId(ctx echo.Context, id int, params GetWebhooksIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	webhook, writeErr, err := s.findWebhook(id)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusOK, webhook)
+}
+
+func (s *Server) PatchWebhooksId(ctx echo.Context, id int, params PatchWebhooksIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	data, err := readRequestBody(ctx.Request().Body)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	input, fieldErrs := decodeWebhookInput(data, false)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+
+	webhook, writeErr, err := s.updateWebhook(id, input)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusOK, webhook)
+}
+
+func (s *Server) DeleteWebhooksId(ctx echo.Context, id int, params DeleteWebhooksIdParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	writeErr, err := s.removeWebhook(id)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) GetWebhooksIdDeadLetters(ctx echo.Context, id int, params GetWebhooksIdDeadLettersParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	deliveries, writeErr, err := s.deadLetters(id, params.Limit, params.Offset)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusOK, deliveries)
+}
+
+func (s *Server) PostWebhooksIdDeliveriesDeliveryIdRedeliver(ctx echo.Context, id int, deliveryId int, params PostWebhooksIdDeliveriesDeliveryIdRedeliverParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	delivery, writeErr, err := s.redeliver(id, deliveryId)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+	return ctx.JSON(http.StatusAccepted, delivery)
+}
+
+// Reads page of webhooks. Used by REST and gRPC APIs
+func (s *Server) listWebhooks(limit *int, offset *int) ([]Webhook, *bannerWriteError, error) {
+	if fieldErrs := validateLimitOffset(limit, offset); len(fieldErrs) > 0 {
+		return nil, invalidBannerError(fieldErrs), nil
+	}
+
+	query, args := appendLimitOffset("SELECT "+webhookColumns+" FROM webhooks ORDER BY id", nil, limit, offset)
+	rows, err := s.db.Query(query, args...)
+
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	webhooks := []Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, nil, rows.Err()
+}
+
+// Stores webhook from validated input. Webhook without secret gets a generated one. Used by REST and gRPC APIs
+func (s *Server) createWebhook(input webhookInput) (WebhookCreated, error) {
+	active := true
+	if input.active != nil {
+		active = *input.active
+	}
+
+	var secret string
+	var err error
+	if input.secret != nil {
+		secret = *input.secret
+	} else {
+		secret, err = generateWebhookSecret()
+
+		if err != nil {
+			return WebhookCreated{}, err
+		}
+	}
+
+	query := "INSERT INTO webhooks (url, secret, events, active) VALUES ($1, $2, $3, $4) RETURNING " + webhookColumns
+	webhook, err := scanWebhook(s.db.QueryRow(query, *input.url, secret, pq.Array(input.events), active))
+
+	// Secret is shown once, later responses leave it out
+	return WebhookCreated{
+		Id:        webhook.Id,
+		Url:       webhook.Url,
+		Events:    webhook.Events,
+		Active:    webhook.Active,
+		Secret:    secret,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}, err
+}
+
+// Reads webhook by id. Used by REST and gRPC APIs
+func (s *Server) findWebhook(id int) (Webhook, *bannerWriteError, error) {
+	webhook, err := scanWebhook(s.db.QueryRow("SELECT "+webhookColumns+" FROM webhooks WHERE id = $1", id))
+
+	if err == sql.ErrNoRows {
+		return webhook, &bannerWriteError{status: http.StatusNotFound, message: "Подписка не найдена"}, nil
+	}
+	return webhook, nil, err
+}
+
+// Updates fields of webhook set in validated input. Used by REST and gRPC APIs
+func (s *Server) updateWebhook(id int, input webhookInput) (Webhook, *bannerWriteError, error) {
+	query, args := updateWebhookQueryBuilder(id, input)
+	webhook, err := scanWebhook(s.db.QueryRow(query, args...))
+
+	if err == sql.ErrNoRows {
+		return webhook, &bannerWriteError{status: http.StatusNotFound, message: "Подписка не найдена"}, nil
+	}
+	return webhook, nil, err
+}
+
+// Deletes webhook. Used by REST and gRPC APIs
+func (s *Server) removeWebhook(id int) (*bannerWriteError, error) {
+	// Deliveries of the webhook are deleted by cascade
+	result, err := s.db.Exec("DELETE FROM webhooks WHERE id = $1", id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := result.RowsAffected()
+
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return &bannerWriteError{status: http.StatusNotFound, message: "Подписка не найдена"}, nil
+	}
+	return nil, nil
+}
+
+// Reads page of dead deliveries of webhook, the newest first. Used by REST and gRPC APIs
+func (s *Server) deadLetters(id int, limit *int, offset *int) ([]WebhookDelivery, *bannerWriteError, error) {
+	if fieldErrs := validateLimitOffset(limit, offset); len(fieldErrs) > 0 {
+		return nil, invalidBannerError(fieldErrs), nil
+	}
+
+	var exists bool
+	err := s.db.QueryRow("SELECT EXISTS (SELECT 1 FROM webhooks WHERE id = $1)", id).Scan(&exists)
+
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exists {
+		return nil, &bannerWriteError{status: http.StatusNotFound, message: "Подписка не найдена"}, nil
+	}
+
+	query := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries d JOIN banner_events e ON e.id = d.event_id" +
+		" WHERE d.webhook_id = $1 AND d.status = 'dead' ORDER BY d.id DESC"
+	query, args := appendLimitOffset(query, []interface{}{id}, limit, offset)
+	rows, err := s.db.Query(query, args...)
+
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	deliveries := []WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil, rows.Err()
+}
+
+// Queues dead delivery of webhook again. Used by REST and gRPC APIs
+func (s *Server) redeliver(id int, deliveryID int) (WebhookDelivery, *bannerWriteError, error) {
+	// Last status and error are kept until the next attempt, so it is still visible why the delivery died
+	query := "UPDATE webhook_deliveries SET status = 'pending', attempts = 0, next_attempt_at = now()" +
+		" WHERE id = $1 AND webhook_id = $2 AND status = 'dead'"
+	result, err := s.db.Exec(query, deliveryID, id)
+
+	if err != nil {
+		return WebhookDelivery{}, nil, err
+	}
+
+	updated, err := result.RowsAffected()
+
+	if err != nil {
+		return WebhookDelivery{}, nil, err
+	}
+
+	query = "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries d JOIN banner_events e ON e.id = d.event_id" +
+		" WHERE d.id = $1 AND d.webhook_id = $2"
+	delivery, err := scanWebhookDelivery(s.db.QueryRow(query, deliveryID, id))
+
+	if err == sql.ErrNoRows {
+		return delivery, &bannerWriteError{status: http.StatusNotFound, message: "Доставка не найдена"}, nil
+	} else if err != nil {
+		return delivery, nil, err
+	}
+	if updated == 0 {
+		return delivery, &bannerWriteError{status: http.StatusConflict, message: "Доставка не в списке недоставленных"}, nil
+	}
+	return delivery, nil, nil
+}
+
+// Validates limit and offset of list requests
+func validateLimitOffset(limit *int, offset *int) []FieldError {
+	var fieldErrs []FieldError
+	if limit != nil && *limit < 0 {
+		fieldErrs = append(fieldErrs, FieldError{Field: "limit", Message: "must not be negative"})
+	}
+	if offset != nil && *offset < 0 {
+		fieldErrs = append(fieldErrs, FieldError{Field: "offset", Message: "must not be negative"})
+	}
+	return fieldErrs
+}
+
+// Appends LIMIT and OFFSET to query with args already bound
+func appendLimitOffset(query string, args []interface{}, limit *int, offset *int) (string, []interface{}) {
+	if limit != nil {
+		args = append(args, *limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if offset != nil {
+		args = append(args, *offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+	return query, args
+}
+
+// Wrapper function for building params for PatchWebhooksId query. Update without fields only selects the webhook
+func updateWebhookQueryBuilder(id int, input webhookInput) (string, []interface{}) {
+	query := "UPDATE webhooks SET"
+	args := []interface{}{}
+	count := 1
+
+	for _, field := range []struct {
+		column string
+		value  interface{}
+		set    bool
+	}{
+		{"url", input.url, input.url != nil},
+		{"events", pq.Array(input.events), input.events != nil},
+		{"secret", input.secret, input.secret != nil},
+		{"active", input.active, input.active != nil},
+	} {
+		if !field.set {
+			continue
+		}
+		if count > 1 {
+			query += ","
+		}
+		query += fmt.Sprintf(" %s = $%d", field.column, count)
+		args = append(args, field.value)
+		count++
+	}
+
+	if count == 1 {
+		return "SELECT " + webhookColumns + " FROM webhooks WHERE id = $1", []interface{}{id}
+	}
+	query += fmt.Sprintf(" WHERE id = $%d RETURNING %s", count, webhookColumns)
+	args = append(args, id)
+	return query, args
+}
+
+// Scans webhook selected with webhookColumns
+func scanWebhook(row rowScanner) (Webhook, error) {
+	var webhook Webhook
+	var events pq.StringArray
+	err := row.Scan(&webhook.Id, &webhook.Url, &events, &webhook.Active, &webhook.CreatedAt, &webhook.UpdatedAt)
+
+	if err != nil {
+		return webhook, err
+	}
+
+	webhook.Events = make([]WebhookEventType, len(events))
+	for i, event := range events {
+		webhook.Events[i] = WebhookEventType(event)
+	}
+	return webhook, nil
+}
+
+// Scans delivery selected with webhookDeliveryColumns. Next attempt is only reported for pending deliveries
+func scanWebhookDelivery(row rowScanner) (WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	var nextAttemptAt time.Time
+	var lastStatus sql.NullInt64
+	var lastError sql.NullString
+	var deliveredAt sql.NullTime
+	var changes []byte
+	err := row.Scan(&delivery.Id, &delivery.WebhookId, &delivery.Status, &delivery.Attempts, &nextAttemptAt, &lastStatus, &lastError,
+		&delivery.CreatedAt, &deliveredAt, &delivery.Event.Id, &delivery.Event.Type, &delivery.Event.BannerId, &changes, &delivery.Event.OccurredAt)
+
+	if err != nil {
+		return delivery, err
+	}
+	if delivery.Status == Pending {
+		delivery.NextAttemptAt = &nextAttemptAt
+	}
+	if lastStatus.Valid {
+		status := int(lastStatus.Int64)
+		delivery.LastStatus = &status
+	}
+	if lastError.Valid {
+		delivery.LastError = &lastError.String
+	}
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+	return delivery, json.Unmarshal(changes, &delivery.Event.Changes)
+}
+
+// Generates random secret for webhook created without one
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
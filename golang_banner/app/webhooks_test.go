@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var webhookDeliveryRowColumns = []string{"id", "webhook_id", "status", "attempts", "next_attempt_at", "last_status", "last_error", "created_at", "delivered_at",
+	"event_id", "type", "banner_id", "changes", "occurred_at"}
+
+func TestPostWebhooks(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+
+	// Secret is generated, duplicate events are dropped
+	db_mock.ExpectQuery("INSERT INTO webhooks (url, secret, events, active) VALUES ($1, $2, $3, $4) RETURNING "+webhookColumns).
+		WithArgs("https://cdn.example.com/purge", sqlmock.AnyArg(), pq.Array([]string{"updated", "deleted"}), true).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "events", "active", "created_at", "updated_at"}).
+			AddRow(3, "https://cdn.example.com/purge", "{updated,deleted}", true, now, now))
+
+	e := echo.New()
+	body := `{"url": "https://cdn.example.com/purge", "events": ["updated", "deleted", "updated"]}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.PostWebhooks(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var webhook WebhookCreated
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &webhook))
+		assert.Equal(t, 3, webhook.Id)
+		assert.Len(t, webhook.Secret, 64)
+		assert.Equal(t, []WebhookEventType{Updated, Deleted}, webhook.Events)
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDecodeWebhookInput(t *testing.T) {
+	for _, test := range []struct {
+		body   string
+		create bool
+		field  string
+	}{
+		{`{"events": ["created"]}`, true, "url"},
+		{`{"url": "https://example.com"}`, true, "events"},
+		{`{"url": "ftp://example.com", "events": ["created"]}`, true, "url"},
+		{`{"url": "/hook", "events": ["created"]}`, true, "url"},
+		{`{"url": "https://example.com", "events": []}`, true, "events"},
+		{`{"url": "https://example.com", "events": ["renamed"]}`, true, "events[0]"},
+		{`{"url": "https://example.com", "events": ["created"], "secret": "short"}`, true, "secret"},
+		{`{"active": null}`, false, "active"},
+		{`{"headers": {}}`, false, "headers"},
+	} {
+		_, errs := decodeWebhookInput([]byte(test.body), test.create)
+
+		if assert.Len(t, errs, 1, test.body) {
+			assert.Equal(t, test.field, errs[0].Field, test.body)
+		}
+	}
+
+	input, errs := decodeWebhookInput([]byte(`{"active": false}`), false)
+
+	assert.Empty(t, errs)
+	assert.Nil(t, input.url)
+	assert.Nil(t, input.events)
+	assert.False(t, *input.active)
+}
+
+func TestBannerEventTypes(t *testing.T) {
+	assert.Equal(t, []string{eventCreated}, bannerEventTypes(auditCreate, auditFieldDiff("is_active", nil, true)))
+	assert.Equal(t, []string{eventDeleted}, bannerEventTypes(auditDelete, nil))
+	assert.Equal(t, []string{eventUpdated}, bannerEventTypes(auditPublish, auditFieldDiff("state", "approved", "published")))
+	assert.Equal(t, []string{eventUpdated}, bannerEventTypes(auditUpdate, auditFieldDiff("is_active", true, false)))
+	assert.Equal(t, []string{eventUpdated}, bannerEventTypes(auditUpdate, auditFieldDiff("is_active", false, true)))
+	assert.Equal(t, []string{eventUpdated, eventActivated}, bannerEventTypes(auditPublish, auditFieldDiff("published_is_active", false, true)))
+	assert.Equal(t, []string{eventUpdated, eventActivated}, bannerEventTypes(auditPublish, auditFieldDiff("published_is_active", nil, true)))
+	assert.Equal(t, []string{eventUpdated}, bannerEventTypes(auditPublish, auditFieldDiff("published_is_active", true, false)))
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, webhookBaseBackoff, webhookBackoff(1))
+	assert.Equal(t, 4*webhookBaseBackoff, webhookBackoff(3))
+	assert.Equal(t, webhookMaxBackoff, webhookBackoff(webhookMaxAttempts))
+}
+
+func TestDeliverNextWebhook(t *testing.T) {
+	occurredAt := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	claimRows := func(url string, attempts int) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "attempts", "url", "secret", "event_id", "type", "banner_id", "changes", "occurred_at"}).
+			AddRow(21, attempts, url, "0123456789abcdef", 5, "activated", 7, []byte(`{"is_active": {"before": false, "after": true}}`), occurredAt)
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		server, db_mock, _ := newTestServer(t)
+		var received *http.Request
+		var body []byte
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+		server.webhookClient = receiver.Client()
+
+		db_mock.ExpectQuery(claimWebhookDeliveryQuery).
+			WithArgs(webhookLease.Seconds()).
+			WillReturnRows(claimRows(receiver.URL, 1))
+		db_mock.ExpectExec("UPDATE webhook_deliveries SET status = 'delivered', delivered_at = now(), last_status = $2, last_error = NULL WHERE id = $1").
+			WithArgs(21, http.StatusNoContent).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.True(t, server.deliverNextWebhook(context.Background()))
+
+		if assert.NotNil(t, received) {
+			assert.Equal(t, webhookSignature("0123456789abcdef", body), received.Header.Get("X-Webhook-Signature"))
+			assert.Equal(t, "activated", received.Header.Get("X-Webhook-Event"))
+			assert.Equal(t, "21", received.Header.Get("X-Webhook-Delivery"))
+			assert.JSONEq(t, `{
+				"id": 5,
+				"type": "activated",
+				"banner_id": 7,
+				"changes": {"is_active": {"before": false, "after": true}},
+				"occurred_at": "2024-04-01T12:00:00Z"
+			}`, string(body))
+		}
+
+		if err := db_mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("failed attempts are retried and then dead", func(t *testing.T) {
+		server, db_mock, _ := newTestServer(t)
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer receiver.Close()
+		server.webhookClient = receiver.Client()
+
+		db_mock.ExpectQuery(claimWebhookDeliveryQuery).
+			WithArgs(webhookLease.Seconds()).
+			WillReturnRows(claimRows(receiver.URL, 2))
+		db_mock.ExpectExec("UPDATE webhook_deliveries SET next_attempt_at = now() + make_interval(secs => $4), last_status = $2, last_error = $3 WHERE id = $1").
+			WithArgs(21, http.StatusServiceUnavailable, "unexpected status 503", webhookBackoff(2).Seconds()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		db_mock.ExpectQuery(claimWebhookDeliveryQuery).
+			WithArgs(webhookLease.Seconds()).
+			WillReturnRows(claimRows(receiver.URL, webhookMaxAttempts))
+		db_mock.ExpectExec("UPDATE webhook_deliveries SET status = 'dead', last_status = $2, last_error = $3 WHERE id = $1").
+			WithArgs(21, http.StatusServiceUnavailable, "unexpected status 503").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.True(t, server.deliverNextWebhook(context.Background()))
+		assert.True(t, server.deliverNextWebhook(context.Background()))
+
+		if err := db_mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func TestPostWebhookRedeliver(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	now := time.Now()
+	updateQuery := "UPDATE webhook_deliveries SET status = 'pending', attempts = 0, next_attempt_at = now()" +
+		" WHERE id = $1 AND webhook_id = $2 AND status = 'dead'"
+	selectQuery := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries d JOIN banner_events e ON e.id = d.event_id" +
+		" WHERE d.id = $1 AND d.webhook_id = $2"
+	row := func(status string, attempts int) *sqlmock.Rows {
+		return sqlmock.NewRows(webhookDeliveryRowColumns).
+			AddRow(21, 3, status, attempts, now, 503, "unexpected status 503", now, nil, 5, "updated", 7, []byte(`{}`), now)
+	}
+
+	// Dead delivery is queued again
+	db_mock.ExpectExec(updateQuery).WithArgs(21, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+	db_mock.ExpectQuery(selectQuery).WithArgs(21, 3).WillReturnRows(row("pending", 0))
+	// Delivered one is not
+	db_mock.ExpectExec(updateQuery).WithArgs(21, 3).WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectQuery(selectQuery).WithArgs(21, 3).WillReturnRows(row("delivered", 1))
+	// Delivery of another webhook
+	db_mock.ExpectExec(updateQuery).WithArgs(21, 3).WillReturnResult(sqlmock.NewResult(0, 0))
+	db_mock.ExpectQuery(selectQuery).WithArgs(21, 3).WillReturnRows(sqlmock.NewRows(webhookDeliveryRowColumns))
+
+	for _, code := range []int{http.StatusAccepted, http.StatusConflict, http.StatusNotFound} {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/3/deliveries/21/redeliver", nil)
+		req.Header.Set("token", "IGOTTHEPOWER!")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id", "delivery_id")
+		c.SetParamValues("3", "21")
+
+		if assert.NoError(t, wrapper.PostWebhooksIdDeliveriesDeliveryIdRedeliver(c)) {
+			assert.Equal(t, code, rec.Code)
+		}
+		if code == http.StatusAccepted {
+			var delivery WebhookDelivery
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &delivery))
+			assert.Equal(t, Pending, delivery.Status)
+			assert.NotNil(t, delivery.NextAttemptAt)
+			assert.Equal(t, 503, *delivery.LastStatus)
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestWebhooksRequireAdmin(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+	req.Header.Set("token", "IMACREEP")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetWebhooks(c)) {
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	}
+}
@@ -0,0 +1,314 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+// States of banner review. Values are shared with the state filter of GET /banner
+const (
+	stateDraft         = string(Draft)
+	statePendingReview = string(PendingReview)
+	stateApproved      = string(Approved)
+	statePublished     = string(Published)
+	stateArchived      = string(Archived)
+)
+
+var bannerStates = map[string]bool{
+	stateDraft:         true,
+	statePendingReview: true,
+	stateApproved:      true,
+	statePublished:     true,
+	stateArchived:      true,
+}
+
+// Move of banner from one of from states to state to, logged to audit as action. set is appended to SET clause
+// of the update, with comment it may use $3 for review comment
+type bannerTransition struct {
+	action   string
+	from     []string
+	to       string
+	set      string
+	comment  bool
+	conflict string
+}
+
+var (
+	submitTransition = bannerTransition{
+		action:   auditSubmit,
+		from:     []string{stateDraft},
+		to:       statePendingReview,
+		conflict: "Баннер не в состоянии draft",
+	}
+	approveTransition = bannerTransition{
+		action:   auditApprove,
+		from:     []string{statePendingReview},
+		to:       stateApproved,
+		set:      ", review_comment = $3",
+		comment:  true,
+		conflict: "Баннер не в состоянии pending_review",
+	}
+	rejectTransition = bannerTransition{
+		action:   auditReject,
+		from:     []string{statePendingReview},
+		to:       stateDraft,
+		set:      ", review_comment = $3",
+		comment:  true,
+		conflict: "Баннер не в состоянии pending_review",
+	}
+	// Trigger increments revision of updated row, so published revision is the one the row gets
+	publishTransition = bannerTransition{
+		action: auditPublish,
+		from:   []string{stateApproved},
+		to:     statePublished,
+		set: ", published_tag_ids = tag_ids, published_feature_id = feature_id, published_content = content" +
+			", published_is_active = is_active, published_priority = priority, published_revision = revision + 1, published_at = now()",
+		conflict: "Баннер не в состоянии approved",
+	}
+	archiveTransition = bannerTransition{
+		action: auditArchive,
+		from:   []string{stateDraft, statePendingReview, stateApproved, statePublished},
+		to:     stateArchived,
+		set: ", published_tag_ids = NULL, published_feature_id = NULL, published_content = NULL" +
+			", published_is_active = NULL, published_priority = NULL, published_revision = NULL, published_at = NULL",
+		conflict: "Баннер уже в архиве",
+	}
+)
+
+func (t bannerTransition) allows(state string) bool {
+	for _, from := range t.from {
+		if from == state {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) PostBannerIdSubmit(ctx echo.Context, id int, params PostBannerIdSubmitParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.transitBanner(ctx, id, params.IfMatch, submitTransition, nil, s.auditSource(ctx, *params.Token))
+}
+
+func (s *Server) PostBannerIdApprove(ctx echo.Context, id int, params PostBannerIdApproveParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	data, err := readRequestBody(ctx.Request().Body)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	decision, fieldErrs := decodeReviewDecision(data, false)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+	return s.transitBanner(ctx, id, params.IfMatch, approveTransition, decision.Comment, s.auditSource(ctx, *params.Token))
+}
+
+func (s *Server) PostBannerIdReject(ctx echo.Context, id int, params PostBannerIdRejectParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+
+	data, err := readRequestBody(ctx.Request().Body)
+
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, validationError([]FieldError{{Field: "body", Message: err.Error()}}))
+	}
+
+	decision, fieldErrs := decodeReviewDecision(data, true)
+
+	if len(fieldErrs) > 0 {
+		return ctx.JSON(http.StatusBadRequest, validationError(fieldErrs))
+	}
+	return s.transitBanner(ctx, id, params.IfMatch, rejectTransition, decision.Comment, s.auditSource(ctx, *params.Token))
+}
+
+func (s *Server) PostBannerIdPublish(ctx echo.Context, id int, params PostBannerIdPublishParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.transitBanner(ctx, id, params.IfMatch, publishTransition, nil, s.auditSource(ctx, *params.Token))
+}
+
+func (s *Server) PostBannerIdArchive(ctx echo.Context, id int, params PostBannerIdArchiveParams) error {
+	if !validateToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusUnauthorized, "Пользователь не авторизован")
+	}
+	if !validateAdminToken(*params.Token, s.tokens) {
+		return ctx.HTML(http.StatusForbidden, "Пользователь не имеет доступа")
+	}
+	return s.transitBanner(ctx, id, params.IfMatch, archiveTransition, nil, s.auditSource(ctx, *params.Token))
+}
+
+// Applies transition to banner and responds with new ETag
+func (s *Server) transitBanner(ctx echo.Context, id int, ifMatch *string, t bannerTransition, comment *string, audit auditSource) error {
+	revision, writeErr, err := s.applyTransition(id, ifMatch, t, comment, audit)
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, err.Error())
+	}
+	if writeErr != nil {
+		return writeErr.respond(ctx)
+	}
+
+	ctx.Response().Header().Set("ETag", bannerETag(revision))
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Applies transition to banner in a transaction and returns new revision. Cached user banners of published features
+// are dropped, because publish and archive change what users get. Used by REST and gRPC APIs
+func (s *Server) applyTransition(id int, ifMatch *string, t bannerTransition, comment *string, audit auditSource) (int64, *bannerWriteError, error) {
+	var revision int64
+	var featureIDs []int
+	writeErr, err := s.writeInTx(func(tx *sql.Tx) (*bannerWriteError, error) {
+		var writeErr *bannerWriteError
+		var err error
+		revision, featureIDs, writeErr, err = transitBanner(tx, id, ifMatch, t, comment, audit)
+		return writeErr, err
+	})
+
+	if err != nil || writeErr != nil {
+		return 0, writeErr, err
+	}
+	return revision, nil, invalidateFeatureCache(s.ctx, s.cache, featureIDs)
+}
+
+// Locks banner, checks its revision and state, applies transition and logs it to audit. Returns new revision
+// and features whose published banners changed
+func transitBanner(tx *sql.Tx, id int, ifMatch *string, t bannerTransition, comment *string, audit auditSource) (int64, []int, *bannerWriteError, error) {
+	var state string
+	var revision int64
+	var featureID int
+	var publishedFeatureID sql.NullInt64
+	var isActive bool
+	var publishedIsActive sql.NullBool
+	query := "SELECT state, revision, feature_id, published_feature_id, is_active, published_is_active FROM banners WHERE id = $1 AND deleted_at IS NULL FOR UPDATE"
+	err := tx.QueryRow(query, id).Scan(&state, &revision, &featureID, &publishedFeatureID, &isActive, &publishedIsActive)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil, &bannerWriteError{status: http.StatusNotFound, message: "Баннер не найден"}, nil
+		} else {
+			return 0, nil, nil, err
+		}
+	}
+	if ifMatch != nil && !etagMatches(*ifMatch, bannerETag(revision)) {
+		return 0, nil, &bannerWriteError{status: http.StatusPreconditionFailed, message: "Баннер был изменён"}, nil
+	}
+	if !t.allows(state) {
+		return 0, nil, &bannerWriteError{status: http.StatusConflict, message: t.conflict}, nil
+	}
+	if t.to == statePublished {
+		writeErr, err := checkPublishable(tx, id, featureID, isActive)
+
+		if err != nil || writeErr != nil {
+			return 0, nil, writeErr, err
+		}
+	}
+
+	query = "UPDATE banners SET state = $1" + t.set + " WHERE id = $2 RETURNING revision"
+	args := []interface{}{t.to, id}
+
+	if t.comment {
+		args = append(args, comment)
+	}
+
+	err = tx.QueryRow(query, args...).Scan(&revision)
+
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	diff := auditFieldDiff("state", state, t.to)
+	if t.comment && comment != nil {
+		diff["review_comment"] = auditChange(nil, *comment, false, true)
+	}
+	// Publish is what turns banner on or off for users, so webhooks see activation here and not on draft edits
+	if t.to == statePublished && (!publishedIsActive.Valid || publishedIsActive.Bool != isActive) {
+		diff["published_is_active"] = auditChange(publishedIsActive.Bool, isActive, publishedIsActive.Valid, true)
+	}
+	if err := recordBannerChange(tx, audit, t.action, id, diff); err != nil {
+		return 0, nil, nil, err
+	}
+
+	var featureIDs []int
+	if publishedFeatureID.Valid && (t.to == statePublished || t.to == stateArchived) {
+		featureIDs = append(featureIDs, int(publishedFeatureID.Int64))
+	}
+	if t.to == statePublished && (!publishedFeatureID.Valid || int(publishedFeatureID.Int64) != featureID) {
+		featureIDs = append(featureIDs, featureID)
+	}
+	return revision, featureIDs, nil, nil
+}
+
+// Checks edited values of locked banner before they go to users. Since the edit feature or tags could be archived,
+// another banner could take the same feature, tag and priority, and feature could get another schema.
+// Feature is locked, so the uniqueness check and publish can't interleave with writes of the feature
+func checkPublishable(tx *sql.Tx, id int, featureID int, isActive bool) (*bannerWriteError, error) {
+	var tagIDs []int64
+	var content []byte
+	var priority int
+	input := BannerInput{FeatureId: featureID, IsActive: isActive, Priority: &priority}
+	err := tx.QueryRow("SELECT tag_ids, content, priority FROM banners WHERE id = $1", id).Scan(pq.Array(&tagIDs), &content, &priority)
+
+	if err != nil {
+		return nil, err
+	}
+	for _, tagID := range tagIDs {
+		input.TagIds = append(input.TagIds, int(tagID))
+	}
+	if err := json.Unmarshal(content, &input.Content); err != nil {
+		return nil, err
+	}
+
+	if err := lockFeature(tx, featureID); err != nil {
+		return nil, err
+	}
+
+	fieldErrs, err := checkBannerRefs(tx, input, nil)
+
+	if err != nil {
+		return nil, err
+	}
+	if len(fieldErrs) > 0 {
+		return &bannerWriteError{status: http.StatusConflict, message: "banner references archived or deleted feature or tags", fields: fieldErrs}, nil
+	}
+
+	writeErr, err := checkBannerUnique(tx, id, input)
+
+	if err != nil || writeErr != nil {
+		return writeErr, err
+	}
+
+	fieldErrs, err = checkBannerContent(tx, input)
+
+	if err != nil {
+		return nil, err
+	}
+	if len(fieldErrs) > 0 {
+		return &bannerWriteError{status: http.StatusUnprocessableEntity, message: "banner content does not match feature schema", fields: fieldErrs}, nil
+	}
+	return nil, nil
+}
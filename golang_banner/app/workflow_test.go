@@ -0,0 +1,311 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+const workflowSelectQuery = "SELECT state, revision, feature_id, published_feature_id, is_active, published_is_active FROM banners WHERE id = $1 AND deleted_at IS NULL FOR UPDATE"
+
+// Expects checks of edited banner values that publish runs. Feature has no schema and banner has no conflicts
+func expectPublishChecks(db_mock sqlmock.Sqlmock, id int, featureID int, tagIDs []int) {
+	db_mock.ExpectQuery("SELECT tag_ids, content, priority FROM banners WHERE id = $1").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"tag_ids", "content", "priority"}).AddRow(pq.Array(tagIDs), []byte(`{"title":"a"}`), 0))
+	expectFeatureLock(db_mock, featureID)
+	expectBannerRefs(db_mock, featureID, tagIDs)
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(featureID, 0, pq.Array(tagIDs), id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag_id"}))
+	expectContentSchema(db_mock, featureID, nil)
+}
+
+func newWorkflowContext(path string, body string, ifMatch string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+	return c, rec
+}
+
+func TestPostBannerSubmit(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery(workflowSelectQuery).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"state", "revision", "feature_id", "published_feature_id", "is_active", "published_is_active"}).AddRow("draft", 3, 2, nil, true, nil))
+	db_mock.ExpectQuery("UPDATE banners SET state = $1 WHERE id = $2 RETURNING revision").
+		WithArgs("pending_review", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(4))
+	expectBannerChange(db_mock, auditSubmit, 7)
+	db_mock.ExpectCommit()
+
+	c, rec := newWorkflowContext("/banner/7/submit", "", `"3"`)
+
+	if assert.NoError(t, wrapper.PostBannerIdSubmit(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerTransitionRejected(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	rows := func(state string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"state", "revision", "feature_id", "published_feature_id", "is_active", "published_is_active"}).AddRow(state, 3, 2, nil, true, nil)
+	}
+
+	// Stale ETag
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery(workflowSelectQuery).WithArgs(7).WillReturnRows(rows("approved"))
+	db_mock.ExpectRollback()
+	// Banner is not approved yet
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery(workflowSelectQuery).WithArgs(7).WillReturnRows(rows("pending_review"))
+	db_mock.ExpectRollback()
+	// Banner is deleted
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery(workflowSelectQuery).WithArgs(7).WillReturnError(sql.ErrNoRows)
+	db_mock.ExpectRollback()
+
+	for _, test := range []struct {
+		ifMatch string
+		code    int
+	}{
+		{`"2"`, http.StatusPreconditionFailed},
+		{"", http.StatusConflict},
+		{"", http.StatusNotFound},
+	} {
+		c, rec := newWorkflowContext("/banner/7/publish", "", test.ifMatch)
+
+		if assert.NoError(t, wrapper.PostBannerIdPublish(c)) {
+			assert.Equal(t, test.code, rec.Code)
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerReview(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	t.Run("reject requires comment", func(t *testing.T) {
+		for _, body := range []string{"", `{}`, `{"comment": "  "}`} {
+			c, rec := newWorkflowContext("/banner/7/reject", body, "")
+
+			if assert.NoError(t, wrapper.PostBannerIdReject(c)) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Contains(t, rec.Body.String(), `"field":"comment"`)
+			}
+		}
+	})
+
+	t.Run("reject stores comment", func(t *testing.T) {
+		db_mock.ExpectBegin()
+		db_mock.ExpectQuery(workflowSelectQuery).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"state", "revision", "feature_id", "published_feature_id", "is_active", "published_is_active"}).AddRow("pending_review", 4, 2, nil, true, nil))
+		db_mock.ExpectQuery("UPDATE banners SET state = $1, review_comment = $3 WHERE id = $2 RETURNING revision").
+			WithArgs("draft", 7, "Wrong title").
+			WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(5))
+		expectBannerChange(db_mock, auditReject, 7)
+		db_mock.ExpectCommit()
+
+		c, rec := newWorkflowContext("/banner/7/reject", `{"comment": "Wrong title"}`, "")
+
+		if assert.NoError(t, wrapper.PostBannerIdReject(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("approve without comment", func(t *testing.T) {
+		db_mock.ExpectBegin()
+		db_mock.ExpectQuery(workflowSelectQuery).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"state", "revision", "feature_id", "published_feature_id", "is_active", "published_is_active"}).AddRow("pending_review", 4, 2, nil, true, nil))
+		db_mock.ExpectQuery("UPDATE banners SET state = $1, review_comment = $3 WHERE id = $2 RETURNING revision").
+			WithArgs("approved", 7, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(5))
+		expectBannerChange(db_mock, auditApprove, 7)
+		db_mock.ExpectCommit()
+
+		c, rec := newWorkflowContext("/banner/7/approve", "", "")
+
+		if assert.NoError(t, wrapper.PostBannerIdApprove(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerPublishInvalidatesCache(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	// Banner moved from feature 1 to feature 2, users of both features get another banner now
+	db_mock.ExpectBegin()
+	db_mock.ExpectQuery(workflowSelectQuery).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"state", "revision", "feature_id", "published_feature_id", "is_active", "published_is_active"}).AddRow("approved", 5, 2, 1, true, false))
+	expectPublishChecks(db_mock, 7, 2, []int{3})
+	db_mock.ExpectQuery("UPDATE banners SET state = $1"+publishTransition.set+" WHERE id = $2 RETURNING revision").
+		WithArgs("published", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(6))
+	// Published banner was off and draft is on, so webhooks get activation
+	changes := []byte(`{"published_is_active":{"after":true,"before":false},"state":{"after":"published","before":"approved"}}`)
+	db_mock.ExpectExec("INSERT INTO audit_log (actor, action, banner_id, diff, request_id, source_ip) VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''))").
+		WithArgs(sqlmock.AnyArg(), auditPublish, 7, changes, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	db_mock.ExpectExec("INSERT INTO banner_events (type, banner_id, changes) SELECT unnest($1::text[]), $2, $3").
+		WithArgs(pq.Array([]string{eventUpdated, eventActivated}), 7, changes).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	db_mock.ExpectCommit()
+	cache_mock.ExpectScan(0, "1:*", 100).SetVal([]string{"1:3", "1:3,5"}, 0)
+	cache_mock.ExpectDel("1:3", "1:3,5").SetVal(2)
+	cache_mock.ExpectScan(0, "2:*", 100).SetVal([]string{}, 0)
+	cache_mock.ExpectPublish(bannerUpdatesChannel, 1).SetVal(0)
+	cache_mock.ExpectPublish(bannerUpdatesChannel, 2).SetVal(0)
+
+	c, rec := newWorkflowContext("/banner/7/publish", "", `"5"`)
+
+	if assert.NoError(t, wrapper.PostBannerIdPublish(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, `"6"`, rec.Header().Get("ETag"))
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostBannerPublishRejected(t *testing.T) {
+	server, db_mock, _ := newTestServer(t)
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+	selectBanner := func() {
+		db_mock.ExpectBegin()
+		db_mock.ExpectQuery(workflowSelectQuery).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"state", "revision", "feature_id", "published_feature_id", "is_active", "published_is_active"}).AddRow("approved", 5, 2, 1, true, true))
+		db_mock.ExpectQuery("SELECT tag_ids, content, priority FROM banners WHERE id = $1").
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"tag_ids", "content", "priority"}).AddRow("{3}", []byte(`{"title":"a"}`), 0))
+		expectFeatureLock(db_mock, 2)
+	}
+
+	// Tag was archived after the edit
+	selectBanner()
+	db_mock.ExpectQuery("SELECT archived FROM features WHERE id = $1 FOR SHARE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"archived"}).AddRow(false))
+	db_mock.ExpectQuery("SELECT id, archived FROM tags WHERE id = ANY($1::int[]) FOR SHARE").
+		WithArgs(pq.Array([]int{3})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "archived"}).AddRow(3, true))
+	db_mock.ExpectRollback()
+	// Banner 9 took the same feature, tag and priority
+	selectBanner()
+	expectBannerRefs(db_mock, 2, []int{3})
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(2, 0, pq.Array([]int{3}), 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag_id"}).AddRow(9, 3))
+	db_mock.ExpectRollback()
+	// Feature got a schema the content doesn't match
+	selectBanner()
+	expectBannerRefs(db_mock, 2, []int{3})
+	db_mock.ExpectQuery(bannerUniqueQuery).
+		WithArgs(2, 0, pq.Array([]int{3}), 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tag_id"}))
+	expectContentSchema(db_mock, 2, []byte(`{"type": "object", "required": ["text"]}`))
+	db_mock.ExpectRollback()
+
+	for _, test := range []struct {
+		code  int
+		field string
+	}{
+		{http.StatusConflict, "tag_ids[0]"},
+		{http.StatusConflict, "tag_ids"},
+		{http.StatusUnprocessableEntity, "content"},
+	} {
+		c, rec := newWorkflowContext("/banner/7/publish", "", "")
+
+		if assert.NoError(t, wrapper.PostBannerIdPublish(c)) {
+			assert.Equal(t, test.code, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"field":"`+test.field)
+		}
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetUserBannerAdminPreview(t *testing.T) {
+	server, db_mock, cache_mock := newTestServer(t)
+	// Preview is not cached, so cache gets no calls
+	wrapper := ServerInterfaceWrapper{
+		Handler: server,
+	}
+
+	db_mock.ExpectQuery(previewUserBannerQuery).
+		WithArgs(2, pq.Array([]int{3})).
+		WillReturnRows(sqlmock.NewRows([]string{"content", "is_active", "tag"}).AddRow([]byte(`{"title": "draft"}`), false, 3))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/user_banner?tag_id=3&feature_id=2&use_last_revision=true", nil)
+	req.Header.Set("token", "IGOTTHEPOWER!")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, wrapper.GetUserBanner(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"title": "draft"}`, rec.Body.String())
+	}
+
+	if err := db_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+	if err := cache_mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,175 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Request to the server. Token is sent only when set, noToken sends the header empty
+type request struct {
+	method string
+	path   string
+	token  string
+	body   interface{}
+}
+
+// Sends token header with empty value
+const noToken = "\x00"
+
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// Sends request, failing scenario on network errors
+func (st *scenarioT) do(r request) response {
+	st.Helper()
+
+	var body io.Reader
+	if r.body != nil {
+		data, err := json.Marshal(r.body)
+
+		if err != nil {
+			st.Fatalf("Error occcured: %s", err.Error())
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(r.method, suite.baseURL+r.path, body)
+
+	if err != nil {
+		st.Fatalf("Error occcured: %s", err.Error())
+	}
+	if r.body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch r.token {
+	case "":
+	case noToken:
+		req.Header.Set("token", "")
+	default:
+		req.Header.Set("token", r.token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+
+	if err != nil {
+		st.Fatalf("Error occcured: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+
+	if err != nil {
+		st.Fatalf("Error occcured: %s", err.Error())
+	}
+	return response{status: res.StatusCode, header: res.Header, body: data}
+}
+
+// Sends request and decodes JSON response with expected status into v
+func (st *scenarioT) expect(r request, status int, v interface{}) {
+	st.Helper()
+
+	res := st.do(r)
+	if res.status != status {
+		st.Fatalf("%s %s: expected code %d, got %d: %s", r.method, r.path, status, res.status, res.body)
+	}
+	if v != nil {
+		if err := json.Unmarshal(res.body, v); err != nil {
+			st.Fatalf("%s %s: %s: %s", r.method, r.path, err.Error(), res.body)
+		}
+	}
+}
+
+// Names of seeded features and tags are unique, so scenarios of different runs do not collide
+var seedCounter int64
+
+func uniqueName(kind string) string {
+	return fmt.Sprintf("e2e %s %d-%d", kind, time.Now().UnixNano(), atomic.AddInt64(&seedCounter, 1))
+}
+
+// Data scenario seeds through the API. It is deleted from the database when scenario ends
+type fixture struct {
+	st       *scenarioT
+	features []int64
+	tags     []int64
+}
+
+func newFixture(st *scenarioT) *fixture {
+	f := &fixture{st: st}
+	st.Cleanup(f.cleanup)
+	return f
+}
+
+func (f *fixture) feature() int {
+	f.st.Helper()
+
+	var feature struct {
+		ID int `json:"id"`
+	}
+	f.st.expect(request{method: http.MethodPost, path: "/features", token: adminToken, body: map[string]string{"name": uniqueName("feature")}}, http.StatusCreated, &feature)
+	f.features = append(f.features, int64(feature.ID))
+	return feature.ID
+}
+
+func (f *fixture) tag() int {
+	f.st.Helper()
+
+	var tag struct {
+		ID int `json:"id"`
+	}
+	f.st.expect(request{method: http.MethodPost, path: "/tags", token: adminToken, body: map[string]string{"name": uniqueName("tag")}}, http.StatusCreated, &tag)
+	f.tags = append(f.tags, int64(tag.ID))
+	return tag.ID
+}
+
+// Creates draft banner and returns its id
+func (f *fixture) banner(featureID int, tagIDs []int, content map[string]interface{}, isActive bool) int {
+	f.st.Helper()
+
+	var created struct {
+		BannerID int `json:"banner_id"`
+	}
+	body := map[string]interface{}{"feature_id": featureID, "tag_ids": tagIDs, "content": content, "is_active": isActive}
+	f.st.expect(request{method: http.MethodPost, path: "/banner", token: adminToken, body: body}, http.StatusCreated, &created)
+	return created.BannerID
+}
+
+// Moves banner through review to users
+func (f *fixture) publish(id int) {
+	f.st.Helper()
+
+	for _, action := range []string{"submit", "approve", "publish"} {
+		f.st.expect(request{method: http.MethodPost, path: fmt.Sprintf("/banner/%d/%s", id, action), token: adminToken}, http.StatusNoContent, nil)
+	}
+}
+
+// Deletes banners of seeded features, then features and tags. Audit log is append-only, so its entries stay
+func (f *fixture) cleanup() {
+	queries := []struct {
+		query string
+		ids   []int64
+	}{
+		{"DELETE FROM banners WHERE feature_id = ANY($1)", f.features},
+		{"DELETE FROM features WHERE id = ANY($1)", f.features},
+		{"DELETE FROM tags WHERE id = ANY($1)", f.tags},
+	}
+	for _, q := range queries {
+		if len(q.ids) == 0 {
+			continue
+		}
+		if _, err := suite.db.Exec(q.query, pq.Array(q.ids)); err != nil {
+			f.st.Errorf("cleanup: %s", err.Error())
+		}
+	}
+}
@@ -0,0 +1,244 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateBanner(t *testing.T) {
+	// Feature and tags that do not exist
+	const missingID = 1 << 30
+
+	tests := []struct {
+		name string
+		// Banner of the same feature and tag is created first
+		existing bool
+		body     func(feature int, tag int) map[string]interface{}
+		token    string
+		status   int
+		field    string
+	}{
+		{
+			name: "creates draft banner",
+			body: func(feature, tag int) map[string]interface{} {
+				return map[string]interface{}{"feature_id": feature, "tag_ids": []int{tag}, "content": map[string]string{"title": "a"}, "is_active": true}
+			},
+			token:  adminToken,
+			status: http.StatusCreated,
+		},
+		{
+			name: "feature does not exist",
+			body: func(_, tag int) map[string]interface{} {
+				return map[string]interface{}{"feature_id": missingID, "tag_ids": []int{tag}, "content": map[string]string{}, "is_active": true}
+			},
+			token:  adminToken,
+			status: http.StatusBadRequest,
+			field:  "feature_id",
+		},
+		{
+			name: "tag does not exist",
+			body: func(feature, _ int) map[string]interface{} {
+				return map[string]interface{}{"feature_id": feature, "tag_ids": []int{missingID}, "content": map[string]string{}, "is_active": true}
+			},
+			token:  adminToken,
+			status: http.StatusBadRequest,
+			field:  "tag_ids[0]",
+		},
+		{
+			name:     "feature and tag have banner",
+			existing: true,
+			body: func(feature, tag int) map[string]interface{} {
+				return map[string]interface{}{"feature_id": feature, "tag_ids": []int{tag}, "content": map[string]string{}, "is_active": true}
+			},
+			token:  adminToken,
+			status: http.StatusConflict,
+		},
+		{
+			name: "user can't create banners",
+			body: func(feature, tag int) map[string]interface{} {
+				return map[string]interface{}{"feature_id": feature, "tag_ids": []int{tag}, "content": map[string]string{}, "is_active": true}
+			},
+			token:  userToken,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			st := scenario(t)
+			f := newFixture(st)
+
+			feature, tag := f.feature(), f.tag()
+			if tc.existing {
+				f.banner(feature, []int{tag}, map[string]interface{}{}, true)
+			}
+
+			body := tc.body(feature, tag)
+			res := st.do(request{method: http.MethodPost, path: "/banner", token: tc.token, body: body})
+			assert.Equal(st, tc.status, res.status, string(res.body))
+
+			if tc.field != "" {
+				var validation struct {
+					Fields []struct {
+						Field string `json:"field"`
+					} `json:"fields"`
+				}
+				if assert.NoError(st, json.Unmarshal(res.body, &validation)) && assert.NotEmpty(st, validation.Fields) {
+					assert.Equal(st, tc.field, validation.Fields[0].Field)
+				}
+			}
+
+			if res.status != http.StatusCreated {
+				return
+			}
+
+			var created struct {
+				BannerID int `json:"banner_id"`
+			}
+			assert.NoError(st, json.Unmarshal(res.body, &created))
+
+			var banner map[string]interface{}
+			st.expect(request{method: http.MethodGet, path: fmt.Sprintf("/banner/%d", created.BannerID), token: adminToken}, http.StatusOK, &banner)
+			assert.Equal(st, float64(feature), banner["feature_id"])
+			assert.Equal(st, []interface{}{float64(tag)}, banner["tag_ids"])
+			assert.Equal(st, map[string]interface{}{"title": "a"}, banner["content"])
+			assert.Equal(st, "draft", banner["state"])
+		})
+	}
+}
+
+func TestChangeBanner(t *testing.T) {
+	published := map[string]interface{}{"title": "published"}
+	edited := map[string]interface{}{"title": "edited"}
+
+	tests := []struct {
+		name string
+		// Changes published banner
+		change func(st *scenarioT, f *fixture, id int)
+		// Content users get after the change, nil if they get no banner
+		userContent map[string]interface{}
+		// Content admins preview with use_last_revision
+		previewContent map[string]interface{}
+	}{
+		{
+			name: "edit is not shown before publish",
+			change: func(st *scenarioT, f *fixture, id int) {
+				st.expect(request{method: http.MethodPatch, path: fmt.Sprintf("/banner/%d", id), token: adminToken, body: map[string]interface{}{"content": edited}}, http.StatusOK, nil)
+			},
+			userContent:    published,
+			previewContent: edited,
+		},
+		{
+			name: "published edit is shown",
+			change: func(st *scenarioT, f *fixture, id int) {
+				st.expect(request{method: http.MethodPatch, path: fmt.Sprintf("/banner/%d", id), token: adminToken, body: map[string]interface{}{"content": edited}}, http.StatusOK, nil)
+				f.publish(id)
+			},
+			userContent:    edited,
+			previewContent: edited,
+		},
+		{
+			name: "deleted banner is not shown",
+			change: func(st *scenarioT, f *fixture, id int) {
+				st.expect(request{method: http.MethodDelete, path: fmt.Sprintf("/banner/%d", id), token: adminToken}, http.StatusNoContent, nil)
+			},
+		},
+		{
+			name: "restored banner is shown",
+			change: func(st *scenarioT, f *fixture, id int) {
+				st.expect(request{method: http.MethodDelete, path: fmt.Sprintf("/banner/%d", id), token: adminToken}, http.StatusNoContent, nil)
+				st.expect(request{method: http.MethodPost, path: fmt.Sprintf("/banner/%d/restore", id), token: adminToken}, http.StatusNoContent, nil)
+			},
+			userContent:    published,
+			previewContent: published,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			st := scenario(t)
+			f := newFixture(st)
+
+			feature, tag := f.feature(), f.tag()
+			id := f.banner(feature, []int{tag}, published, true)
+			f.publish(id)
+			tc.change(st, f, id)
+
+			query := fmt.Sprintf("/user_banner?tag_id=%d&feature_id=%d", tag, feature)
+			for _, check := range []struct {
+				path    string
+				token   string
+				content map[string]interface{}
+			}{
+				{query, userToken, tc.userContent},
+				{query + "&use_last_revision=true", adminToken, tc.previewContent},
+			} {
+				res := st.do(request{method: http.MethodGet, path: check.path, token: check.token})
+				if check.content == nil {
+					assert.Equal(st, http.StatusNotFound, res.status, string(res.body))
+					continue
+				}
+
+				var content map[string]interface{}
+				if assert.Equal(st, http.StatusOK, res.status, string(res.body)) && assert.NoError(st, json.Unmarshal(res.body, &content)) {
+					assert.Equal(st, check.content, content, check.path)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteFeatureOfPublishedRevision(t *testing.T) {
+	st := scenario(t)
+	f := newFixture(st)
+
+	// Banner moves to another feature in a draft, users still get it by the published one
+	published, edited := f.feature(), f.feature()
+	id := f.banner(published, []int{f.tag()}, map[string]interface{}{"title": "published"}, true)
+	f.publish(id)
+	st.expect(request{method: http.MethodPatch, path: fmt.Sprintf("/banner/%d", id), token: adminToken, body: map[string]interface{}{"feature_id": edited}}, http.StatusOK, nil)
+
+	res := st.do(request{method: http.MethodDelete, path: fmt.Sprintf("/features/%d", published), token: adminToken})
+	assert.Equal(st, http.StatusConflict, res.status, string(res.body))
+}
+
+func TestBulkOpposingBatches(t *testing.T) {
+	st := scenario(t)
+	f := newFixture(st)
+
+	feature := f.feature()
+	first := f.banner(feature, []int{f.tag()}, map[string]interface{}{"title": "first"}, true)
+	second := f.banner(feature, []int{f.tag()}, map[string]interface{}{"title": "second"}, true)
+
+	// Atomic batches patch the same banners in opposite order. Rows are locked in one order, so none of them deadlocks
+	patch := func(ids ...int) map[string]interface{} {
+		items := make([]map[string]interface{}, len(ids))
+		for i, id := range ids {
+			items[i] = map[string]interface{}{"op": "patch", "id": id, "banner": map[string]interface{}{"content": map[string]interface{}{"title": fmt.Sprintf("patched %d", i)}}}
+		}
+		return map[string]interface{}{"mode": "atomic", "items": items}
+	}
+	for round := 0; round < 10; round++ {
+		statuses := make([]int, 2)
+		var wg sync.WaitGroup
+		for i, body := range []map[string]interface{}{patch(first, second), patch(second, first)} {
+			wg.Add(1)
+			go func(i int, body map[string]interface{}) {
+				defer wg.Done()
+
+				res := st.do(request{method: http.MethodPost, path: "/banner/bulk", token: adminToken, body: body})
+				statuses[i] = res.status
+			}(i, body)
+		}
+		wg.Wait()
+
+		assert.Equal(st, []int{http.StatusOK, http.StatusOK}, statuses, "round %d", round)
+	}
+}
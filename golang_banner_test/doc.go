@@ -0,0 +1,8 @@
+// Package e2e holds end-to-end tests of Golang Banner. Tests are behind the e2e build tag:
+//
+//	go test -tags e2e ./... -args -junit report.xml
+//
+// The suite builds the server from ../golang_banner/app and starts it on free ports.
+// Postgres is taken from E2E_DATABASE_URL, without it a throwaway cluster is created with initdb and pg_ctl.
+// Redis is taken from E2E_REDIS_URL, without it an in-memory Redis is started
+package e2e
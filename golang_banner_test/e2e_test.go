@@ -0,0 +1,256 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/lib/pq"
+)
+
+const (
+	adminToken = "IGOTTHEPOWER!"
+	userToken  = "IMACREEP"
+
+	// Server applies migrations on start, so it may take a while to get ready
+	serverStartTimeout = 60 * time.Second
+)
+
+var (
+	junitPath = flag.String("junit", "", "file to write JUnit XML report to")
+	serverDir = flag.String("server", "../golang_banner/app", "directory of the server sources")
+)
+
+// Environment shared by scenarios
+var suite struct {
+	baseURL string
+	db      *sql.DB
+	report  *junitReport
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	os.Exit(runSuite(m))
+}
+
+// Starts dependencies and the server, runs tests and writes report. Returns exit code
+func runSuite(m *testing.M) int {
+	dir, err := os.MkdirTemp("", "banner-e2e-")
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer os.RemoveAll(dir)
+
+	databaseURL, stopPostgres, err := startPostgres(dir)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "postgres: %s\n", err)
+		return 1
+	}
+	defer stopPostgres()
+
+	redisURL := os.Getenv("E2E_REDIS_URL")
+	if redisURL == "" {
+		redis, err := miniredis.Run()
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "redis: %s\n", err)
+			return 1
+		}
+		defer redis.Close()
+		redisURL = redis.Addr()
+	}
+
+	baseURL, stopServer, err := startServer(dir, databaseURL, redisURL)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "server: %s\n", err)
+		return 1
+	}
+	defer stopServer()
+
+	db, err := sql.Open("postgres", databaseURL)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "postgres: %s\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	suite.baseURL = baseURL
+	suite.db = db
+	suite.report = newJUnitReport()
+	code := m.Run()
+
+	if *junitPath != "" {
+		if err := suite.report.write(*junitPath); err != nil {
+			fmt.Fprintf(os.Stderr, "junit: %s\n", err)
+			return 1
+		}
+	}
+	return code
+}
+
+// Returns URL of E2E_DATABASE_URL or of a throwaway cluster in dir, and function that stops the cluster
+func startPostgres(dir string) (string, func(), error) {
+	if url := os.Getenv("E2E_DATABASE_URL"); url != "" {
+		return url, func() {}, nil
+	}
+
+	for _, tool := range []string{"initdb", "pg_ctl"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			return "", nil, fmt.Errorf("set E2E_DATABASE_URL or put initdb and pg_ctl on PATH: %w", err)
+		}
+	}
+
+	port, err := freePort()
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	data := filepath.Join(dir, "postgres")
+	initdb := exec.Command("initdb", "--pgdata", data, "--username", "postgres", "--auth", "trust", "--no-sync")
+	if out, err := initdb.CombinedOutput(); err != nil {
+		return "", nil, fmt.Errorf("initdb: %w: %s", err, out)
+	}
+
+	// Durability is not needed for tests, turning it off makes them faster
+	options := fmt.Sprintf("-p %d -k %s -c listen_addresses=127.0.0.1 -c fsync=off -c synchronous_commit=off", port, dir)
+	start := exec.Command("pg_ctl", "--pgdata", data, "--log", filepath.Join(dir, "postgres.log"), "--options", options, "--wait", "start")
+	if out, err := start.CombinedOutput(); err != nil {
+		return "", nil, fmt.Errorf("pg_ctl start: %w: %s", err, out)
+	}
+
+	stop := func() {
+		exec.Command("pg_ctl", "--pgdata", data, "--mode", "immediate", "--wait", "stop").Run()
+	}
+	return fmt.Sprintf("postgres://postgres@127.0.0.1:%d/postgres?sslmode=disable", port), stop, nil
+}
+
+// Builds server and starts it on free ports. Returns its URL and function that stops it
+func startServer(dir string, databaseURL string, redisURL string) (string, func(), error) {
+	bin := filepath.Join(dir, "server")
+	build := exec.Command("go", "build", "-o", bin, ".")
+	build.Dir = *serverDir
+	if out, err := build.CombinedOutput(); err != nil {
+		return "", nil, fmt.Errorf("build: %w: %s", err, out)
+	}
+
+	httpPort, err := freePort()
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	grpcPort, err := freePort()
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	logPath := filepath.Join(dir, "server.log")
+	log, err := os.Create(logPath)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	cmd := exec.Command(bin)
+	cmd.Env = append(os.Environ(),
+		"DATABASE_URL="+databaseURL,
+		"REDIS_URL="+redisURL,
+		"MIGRATE_ON_START=true",
+		"REQUIRE_IF_MATCH=false",
+		fmt.Sprintf("HTTP_ADDR=127.0.0.1:%d", httpPort),
+		fmt.Sprintf("GRPC_ADDR=127.0.0.1:%d", grpcPort),
+	)
+	cmd.Stdout, cmd.Stderr = log, log
+	if err := cmd.Start(); err != nil {
+		log.Close()
+		return "", nil, err
+	}
+
+	// Closed when server exits, so both waitReady and stop can wait for it
+	exited := make(chan struct{})
+	var exitErr error
+	go func() {
+		exitErr = cmd.Wait()
+		log.Close()
+		close(exited)
+	}()
+	stop := func() {
+		cmd.Process.Signal(os.Interrupt)
+		select {
+		case <-exited:
+		case <-time.After(5 * time.Second):
+			cmd.Process.Kill()
+			<-exited
+		}
+	}
+
+	baseURL := fmt.Sprintf("http://127.0.0.1:%d", httpPort)
+	if err := waitReady(baseURL, exited, &exitErr); err != nil {
+		stop()
+		out, _ := os.ReadFile(logPath)
+		return "", nil, fmt.Errorf("%w, server log:\n%s", err, out)
+	}
+	return baseURL, stop, nil
+}
+
+// Polls /readyz until server is ready, exits or start timeout passes
+func waitReady(baseURL string, exited <-chan struct{}, exitErr *error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), serverStartTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/readyz", nil)
+
+		if err != nil {
+			return err
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err == nil {
+			res.Body.Close()
+			if res.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		select {
+		case <-exited:
+			return fmt.Errorf("server exited: %v", *exitErr)
+		case <-ctx.Done():
+			return errors.New("server is not ready after start timeout")
+		case <-ticker.C:
+		}
+	}
+}
+
+func freePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
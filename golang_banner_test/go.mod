@@ -1,3 +1,17 @@
 module github.com/Redych-the-kid/go_projects/golang_banner_test
 
 go 1.22.0
+
+require (
+	github.com/alicebob/miniredis/v2 v2.33.0
+	github.com/lib/pq v1.10.9
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/alicebob/gopher-json v0.0.0-20200520072559-a9ecdc9d1d3a // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
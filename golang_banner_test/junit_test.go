@@ -0,0 +1,160 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct{}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitSuite struct {
+	XMLName  xml.Name    `xml:"testsuite"`
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+	elapsed  time.Duration
+}
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+// Results of scenarios in JUnit XML format. Scenarios of one test are a test suite
+type junitReport struct {
+	mu     sync.Mutex
+	suites map[string]*junitSuite
+	order  []string
+}
+
+func newJUnitReport() *junitReport {
+	return &junitReport{suites: make(map[string]*junitSuite)}
+}
+
+func (r *junitReport) add(test string, c junitCase, elapsed time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ts, ok := r.suites[test]
+	if !ok {
+		ts = &junitSuite{Name: test}
+		r.suites[test] = ts
+		r.order = append(r.order, test)
+	}
+	ts.Tests++
+	if c.Failure != nil {
+		ts.Failures++
+	}
+	if c.Skipped != nil {
+		ts.Skipped++
+	}
+	ts.Cases = append(ts.Cases, c)
+	ts.elapsed += elapsed
+	ts.Time = seconds(ts.elapsed)
+}
+
+func (r *junitReport) write(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := junitSuites{}
+	for _, name := range r.order {
+		report.Suites = append(report.Suites, *r.suites[name])
+	}
+
+	data, err := xml.MarshalIndent(report, "", "  ")
+
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(xml.Header), append(data, '\n')...), 0o644)
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// Test of one scenario. Failures are recorded for the report as well as reported to the test
+type scenarioT struct {
+	*testing.T
+	mu       sync.Mutex
+	failures []string
+}
+
+// Starts scenario of subtest t. The report gets its result when the subtest and its cleanups finish
+func scenario(t *testing.T) *scenarioT {
+	st := &scenarioT{T: t}
+	started := time.Now()
+
+	t.Cleanup(func() {
+		test, name, _ := strings.Cut(t.Name(), "/")
+		elapsed := time.Since(started)
+		c := junitCase{Name: name, ClassName: test, Time: seconds(elapsed)}
+
+		st.mu.Lock()
+		defer st.mu.Unlock()
+		if t.Failed() {
+			message := "scenario failed"
+			if len(st.failures) > 0 {
+				message = failureMessage(st.failures[0])
+			}
+			c.Failure = &junitFailure{Message: message, Text: strings.Join(st.failures, "\n\n")}
+		} else if t.Skipped() {
+			c.Skipped = &junitSkipped{}
+		}
+		suite.report.add(test, c, elapsed)
+	})
+	return st
+}
+
+func (st *scenarioT) record(message string) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.failures = append(st.failures, message)
+}
+
+func (st *scenarioT) Errorf(format string, args ...interface{}) {
+	st.T.Helper()
+	st.record(fmt.Sprintf(format, args...))
+	st.T.Errorf(format, args...)
+}
+
+func (st *scenarioT) Fatalf(format string, args ...interface{}) {
+	st.T.Helper()
+	st.record(fmt.Sprintf(format, args...))
+	st.T.Fatalf(format, args...)
+}
+
+// Short message of failure. Assertions put it on the Error line after the trace
+func failureMessage(failure string) string {
+	lines := strings.Split(strings.TrimSpace(failure), "\n")
+	for _, line := range lines {
+		if message, ok := strings.CutPrefix(strings.TrimSpace(line), "Error:"); ok {
+			return strings.TrimSpace(message)
+		}
+	}
+	return lines[0]
+}
@@ -0,0 +1,176 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserBanner(t *testing.T) {
+	content := map[string]interface{}{"key": "value"}
+
+	tests := []struct {
+		name string
+		// Seeded banner of a feature with one tag
+		active  bool
+		publish bool
+		// Query of request, gets ids of the feature, the tag of banner and a tag without banners
+		query   func(feature int, tag int, otherTag int) string
+		token   string
+		status  int
+		content map[string]interface{}
+		// Part of error message of 400 and 401 responses
+		message string
+	}{
+		{
+			name:    "admin gets published banner",
+			active:  true,
+			publish: true,
+			query:   func(feature, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=%d", tag, feature) },
+			token:   adminToken,
+			status:  http.StatusOK,
+			content: content,
+		},
+		{
+			name:    "user gets published banner",
+			active:  true,
+			publish: true,
+			query:   func(feature, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=%d", tag, feature) },
+			token:   userToken,
+			status:  http.StatusOK,
+			content: content,
+		},
+		{
+			name:    "tag without banner",
+			active:  true,
+			publish: true,
+			query: func(feature, _, otherTag int) string {
+				return fmt.Sprintf("tag_id=%d&feature_id=%d", otherTag, feature)
+			},
+			token:  adminToken,
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "draft banner is not shown",
+			active: true,
+			query:  func(feature, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=%d", tag, feature) },
+			token:  userToken,
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "admin previews draft banner",
+			active: true,
+			query: func(feature, tag, _ int) string {
+				return fmt.Sprintf("tag_id=%d&feature_id=%d&use_last_revision=true", tag, feature)
+			},
+			token:   adminToken,
+			status:  http.StatusOK,
+			content: content,
+		},
+		{
+			name:    "user does not get inactive banner",
+			publish: true,
+			query:   func(feature, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=%d", tag, feature) },
+			token:   userToken,
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "admin gets inactive banner",
+			publish: true,
+			query:   func(feature, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=%d", tag, feature) },
+			token:   adminToken,
+			status:  http.StatusOK,
+			content: content,
+		},
+		{
+			name:   "unknown token",
+			active: true,
+			query:  func(feature, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=%d", tag, feature) },
+			token:  "SCAMMER",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:    "no token",
+			active:  true,
+			query:   func(feature, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=%d", tag, feature) },
+			status:  http.StatusUnauthorized,
+			message: "No token was provided",
+		},
+		{
+			name:    "empty token",
+			active:  true,
+			query:   func(feature, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=%d", tag, feature) },
+			token:   noToken,
+			status:  http.StatusBadRequest,
+			message: "Invalid format for parameter token: parameter 'token' is empty, can't bind its value",
+		},
+		{
+			name:    "no tag_id",
+			active:  true,
+			query:   func(feature, _, _ int) string { return fmt.Sprintf("feature_id=%d", feature) },
+			token:   adminToken,
+			status:  http.StatusBadRequest,
+			message: "Invalid format for parameter tag_id: query parameter 'tag_id' is required",
+		},
+		{
+			name:    "no feature_id",
+			active:  true,
+			query:   func(_, tag, _ int) string { return fmt.Sprintf("tag_id=%d", tag) },
+			token:   adminToken,
+			status:  http.StatusBadRequest,
+			message: "Invalid format for parameter feature_id: query parameter 'feature_id' is required",
+		},
+		{
+			name:    "tag_id is not a number",
+			active:  true,
+			query:   func(feature, _, _ int) string { return fmt.Sprintf("tag_id=true&feature_id=%d", feature) },
+			token:   adminToken,
+			status:  http.StatusBadRequest,
+			message: `Invalid format for parameter tag_id`,
+		},
+		{
+			name:    "feature_id is not a number",
+			active:  true,
+			query:   func(_, tag, _ int) string { return fmt.Sprintf("tag_id=%d&feature_id=true", tag) },
+			token:   adminToken,
+			status:  http.StatusBadRequest,
+			message: `Invalid format for parameter feature_id: error binding string parameter: strconv.ParseInt: parsing "true": invalid syntax`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			st := scenario(t)
+			f := newFixture(st)
+
+			feature, tag, otherTag := f.feature(), f.tag(), f.tag()
+			id := f.banner(feature, []int{tag}, content, tc.active)
+			if tc.publish {
+				f.publish(id)
+			}
+
+			res := st.do(request{method: http.MethodGet, path: "/user_banner?" + tc.query(feature, tag, otherTag), token: tc.token})
+			assert.Equal(st, tc.status, res.status, string(res.body))
+
+			if tc.content != nil {
+				var body map[string]interface{}
+				if assert.NoError(st, json.Unmarshal(res.body, &body)) {
+					assert.Equal(st, tc.content, body)
+				}
+			}
+			if tc.message != "" {
+				var body struct {
+					Message string `json:"message"`
+				}
+				if assert.NoError(st, json.Unmarshal(res.body, &body)) {
+					assert.Contains(st, body.Message, tc.message)
+				}
+			}
+		})
+	}
+}